@@ -0,0 +1,101 @@
+// cmd/tracker/commands/activity/activity.go
+package activity
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// Shared flags across activity commands
+type activityFlags struct {
+	// Add/update flags
+	category string
+	aliases  []string
+	mets     []float64
+
+	// List flags
+	all bool
+
+	// Archive flags
+	restore bool
+}
+
+var flags activityFlags
+
+// NewActivityCmd creates the activity command and all its subcommands
+func NewActivityCmd(store storage.StorageManager) *cobra.Command {
+	activityCmd := &cobra.Command{
+		Use:   "activity",
+		Short: "Manage the activity catalog",
+		Long: `Manage the catalog of activities that exercise records are logged against.
+
+Each activity has a category (cardio, strength or sport) and optional aliases,
+so "run" and "jogging" are counted together.
+
+Examples:
+  # Add an activity with aliases
+  tracker activity add swimming --category cardio --alias swim --alias laps
+
+  # List active activities, or everything including archived ones
+  tracker activity list
+  tracker activity list --all
+
+  # Rename an activity; existing records move with it
+  tracker activity rename swimming "open water swimming"
+
+  # Change the category or add aliases
+  tracker activity update swimming --category sport --alias pool
+
+  # Set MET values used for calorie estimates (light,moderate,vigorous)
+  tracker activity update swimming --mets 5.8,8.3,10
+
+  # Archive an activity you no longer do, or bring it back
+  tracker activity archive skiing
+  tracker activity archive skiing --restore`,
+	}
+
+	// Add subcommands
+	activityCmd.AddCommand(
+		newAddCmd(store),
+		newListCmd(store),
+		newRenameCmd(store),
+		newUpdateCmd(store),
+		newArchiveCmd(store),
+	)
+
+	return activityCmd
+}
+
+// parseMETs reads --mets as light, moderate and vigorous values
+func parseMETs(values []float64) (*models.METs, error) {
+	if len(values) != 3 {
+		return nil, fmt.Errorf("--mets takes three values: light,moderate,vigorous")
+	}
+	mets := models.METs{Light: values[0], Moderate: values[1], Vigorous: values[2]}
+	if err := mets.Validate(); err != nil {
+		return nil, err
+	}
+	return &mets, nil
+}
+
+// Add command implementation
+func newAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Add an activity to the catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createAddCmdRunner(store),
+	}
+
+	// Add flags
+	cmd.Flags().StringVarP(&flags.category, "category", "c", "", "Category: cardio, strength or sport (required)")
+	cmd.Flags().StringArrayVarP(&flags.aliases, "alias", "a", nil, "Another name for the activity (repeatable)")
+	cmd.Flags().Float64SliceVar(&flags.mets, "mets", nil, "MET values for light,moderate,vigorous effort (e.g. 5,7,10)")
+
+	cmd.MarkFlagRequired("category")
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+// cmd/tracker/commands/activity/add.go
+package activity
+
+import (
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		category, err := models.ParseActivityCategory(flags.category)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		var mets *models.METs
+		if cmd.Flags().Changed("mets") {
+			if mets, err = parseMETs(flags.mets); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		catalog := settings.GetActivityCatalog()
+
+		activity := models.Activity{
+			Name:     models.ActivityType(args[0]),
+			Category: category,
+			Aliases:  flags.aliases,
+			METs:     mets,
+		}
+		if err := catalog.Add(activity); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.Activities = catalog
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		added := catalog.Find(args[0])
+		display.ShowCommandResult(result.NewSuccess(*added, "Activity added successfully"))
+
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+// cmd/tracker/commands/activity/archive.go
+package activity
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newArchiveCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive NAME",
+		Short: "Archive an activity so it can't be used for new records",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createArchiveCmdRunner(store),
+	}
+
+	cmd.Flags().BoolVar(&flags.restore, "restore", false, "Make an archived activity available again")
+
+	return cmd
+}
+
+func createArchiveCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		catalog := settings.GetActivityCatalog()
+
+		activity := catalog.Find(args[0])
+		if activity == nil {
+			return result.NotFound("Activity", args[0]).Error
+		}
+
+		archive := !flags.restore
+		if activity.Archived == archive {
+			state := "active"
+			if archive {
+				state = "archived"
+			}
+			return result.NewError(fmt.Errorf("activity %s is already %s", activity.Name, state)).Error
+		}
+		activity.Archived = archive
+
+		settings.Activities = catalog
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		message := "Activity archived; existing records are kept"
+		if !archive {
+			message = "Activity restored"
+		}
+		display.ShowCommandResult(result.NewSuccess(*activity, message))
+
+		return nil
+	}
+}
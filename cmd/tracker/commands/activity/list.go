@@ -0,0 +1,39 @@
+// cmd/tracker/commands/activity/list.go
+package activity
+
+import (
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the activity catalog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+
+			var activities models.ActivityCatalog
+			for _, activity := range settings.GetActivityCatalog() {
+				if flags.all || !activity.Archived {
+					activities = append(activities, activity)
+				}
+			}
+
+			display.ShowHeader("Activity Catalog")
+			display.ShowActivityCatalog(activities)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&flags.all, "all", false, "Include archived activities")
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+// cmd/tracker/commands/activity/rename.go
+package activity
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newRenameCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename OLD NEW",
+		Short: "Rename an activity and the records logged against it",
+		Args:  cobra.ExactArgs(2),
+		RunE:  createRenameCmdRunner(store),
+	}
+}
+
+func createRenameCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		catalog := settings.GetActivityCatalog()
+
+		from, to, err := catalog.Rename(args[0], args[1])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.Activities = catalog
+		settings.ExerciseGoals.RenameActivity(from, to)
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Move existing records so history groups under the new name
+		count, err := store.RenameExerciseActivity(from, to)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Gear stays the default for the renamed activity
+		gearList, err := store.GetGear()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		for _, gear := range gearList {
+			if gear.Activity == from {
+				gear.Activity = to
+				if err := store.UpdateGear(gear.ID, gear); err != nil {
+					return result.StorageError(err).Error
+				}
+			}
+		}
+
+		display.ShowCommandResult(result.NewSuccess(*catalog.Find(string(to)),
+			fmt.Sprintf("Activity renamed from %s to %s", from, to),
+			fmt.Sprintf("%d exercise record(s) updated", count)))
+
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+// cmd/tracker/commands/activity/update.go
+package activity
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newUpdateCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Change an activity's category, aliases or MET values",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createUpdateCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.category, "category", "c", "", "New category: cardio, strength or sport")
+	cmd.Flags().StringArrayVarP(&flags.aliases, "alias", "a", nil, "Another name for the activity (repeatable)")
+	cmd.Flags().Float64SliceVar(&flags.mets, "mets", nil, "MET values for light,moderate,vigorous effort (e.g. 5,7,10)")
+
+	return cmd
+}
+
+func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("category") && !cmd.Flags().Changed("alias") && !cmd.Flags().Changed("mets") {
+			return result.ValidationFailed(fmt.Errorf("specify --category, --alias or --mets")).Error
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		catalog := settings.GetActivityCatalog()
+
+		activity := catalog.Find(args[0])
+		if activity == nil {
+			return result.NotFound("Activity", args[0]).Error
+		}
+
+		if cmd.Flags().Changed("category") {
+			category, err := models.ParseActivityCategory(flags.category)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			activity.Category = category
+		}
+
+		if cmd.Flags().Changed("mets") {
+			mets, err := parseMETs(flags.mets)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			activity.METs = mets
+		}
+
+		if err := catalog.AddAliases(activity.Name, flags.aliases); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.Activities = catalog
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(*activity, "Activity updated successfully"))
+
+		return nil
+	}
+}
@@ -3,8 +3,11 @@ package exercise
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/calories"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
 	"github.com/jack-sneddon/my-health-tracker/internal/models"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
@@ -19,10 +22,14 @@ func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []str
 		if err != nil {
 			return result.ValidationFailed(err).Error
 		}
+		if flags.date == "" {
+			// Sessions are stored by day so they group with the day's others
+			date = models.CalendarDate(date)
+		}
 
-		// Validate activity type
-		activity := models.ActivityType(flags.activity)
-		if err := validateActivity(activity, flags.otherActivity); err != nil {
+		// Resolve the activity against the catalog
+		activity, err := resolveActivity(store, flags.activity)
+		if err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
@@ -31,67 +38,231 @@ func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []str
 			return result.ValidationFailed(err).Error
 		}
 
+		intensity, rpe, err := parseEffort(cmd)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		distance, elevation, err := parseDistance(cmd, activity)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		gearID, err := resolveGear(cmd, store, activity.Name)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
 		// Create record
 		record := models.ExerciseRecord{
 			Date:          date,
-			Activity:      activity,
-			OtherActivity: flags.otherActivity,
+			Activity:      activity.Name,
 			Duration:      flags.duration,
+			Intensity:     intensity,
+			RPE:           rpe,
+			Distance:      distance,
+			ElevationGain: elevation,
 			Notes:         flags.notes,
 			Completed:     flags.completed,
+			GearID:        gearID,
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if !confirmPace(record) {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
 		}
 
-		// Try to add record
-		if err := store.AddExercise(record); err != nil {
-			if err.Error() == "duplicate_date" {
-				display.ShowWarning("Record already exists for %s", date.Format(validator.DateFormat))
-				confirmResult := display.ConfirmAction("Do you want to overwrite this record?")
-				if !confirmResult.Confirmed {
-					display.ShowInfo("Operation cancelled")
-					return result.NewError(fmt.Errorf("operation cancelled")).Error
-				}
-				// If confirmed, try to update instead
-				existingRecord, _ := store.GetExercise(date)
-				if existingRecord != nil {
-					// Update the existing record
-					if err := store.UpdateExercise(existingRecord.Date, record); err != nil {
-						return result.StorageError(err).Error
-					}
-				}
-			} else {
-				return result.StorageError(err).Error
-			}
+		// Several sessions can be logged on the same day
+		record, err = store.AddExercise(record)
+		if err != nil {
+			return result.StorageError(err).Error
 		}
 
+		// Report progress toward the daily goal
+		sameDay, err := store.GetExerciseRange(date, date, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		goal, err := goalFor(store, date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		messages := []string{"Exercise record added successfully", dailySummary(sameDay, goal)}
+
+		// Estimate calories from the activity's METs and the closest weigh-in
+		estimator, err := calories.NewEstimator(store)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		estimate, err := estimator.Estimate(record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if estimate != nil {
+			messages = append(messages, estimate.String())
+		} else if !record.IsOutstanding() {
+			messages = append(messages, "Calories unknown: log a weight to estimate them")
+		}
+
+		bests, err := newBestMessages(store, record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, bests...)
+
+		gear, warning, err := gearMessages(store, record.GearID)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, gear...)
+
 		// Use CommandResult for success
-		cmdResult := result.NewSuccess(record, "Exercise record added successfully")
+		cmdResult := result.NewSuccess(record, messages...)
 		display.ShowCommandResult(cmdResult)
+		if warning != "" {
+			display.ShowWarning("%s", warning)
+		}
 
 		return nil
 	}
 }
 
+// goalFor returns the exercise goal in force on a date
+func goalFor(store storage.StorageManager, date time.Time) (models.ExerciseGoal, error) {
+	settings, err := store.GetSettings()
+	if err != nil {
+		return models.ExerciseGoal{}, err
+	}
+	return settings.ExerciseGoals.GoalFor(date), nil
+}
+
+// dailySummary describes a day's sessions against the daily goal
+func dailySummary(records []models.ExerciseRecord, goal models.ExerciseGoal) string {
+	days := models.GroupExerciseByDay(records)
+	if len(days) == 0 {
+		return ""
+	}
+	day := days[0]
+	return fmt.Sprintf("%s: %d minutes across %d session(s), %s",
+		day.Date.Format(validator.DateFormat), day.TotalMinutes, day.Sessions, goal.DailyStatus(day))
+}
+
 // Validation functions
-func validateActivity(activity models.ActivityType, otherActivity string) error {
-	validActivities := map[models.ActivityType]bool{
-		models.Jogging:      true,
-		models.Skiing:       true,
-		models.Walking:      true,
-		models.Cycling:      true,
-		models.MountainBike: true,
-		models.Pickleball:   true,
-		models.Other:        true,
+const ExerciseIDPattern = `^e\d{5}$`
+
+func validateExerciseID(id string) error {
+	if !regexp.MustCompile(ExerciseIDPattern).MatchString(id) {
+		return fmt.Errorf("invalid exercise record ID format. Must be 'e' followed by 5 digits")
 	}
+	return nil
+}
 
-	if !validActivities[activity] {
-		return fmt.Errorf("invalid activity type: %s", activity)
+// resolveActivity looks an activity name or alias up in the catalog and
+// returns its canonical name. Archived activities can't be used for new records.
+func resolveActivity(store storage.StorageManager, name string) (models.Activity, error) {
+	settings, err := store.GetSettings()
+	if err != nil {
+		return models.Activity{}, err
 	}
 
-	if activity == models.Other && otherActivity == "" {
-		return fmt.Errorf("other-activity flag is required when activity type is 'other'")
+	activity := settings.GetActivityCatalog().Find(name)
+	if activity == nil {
+		return models.Activity{}, fmt.Errorf("invalid activity type: %s (see 'tracker activity list' or add it with 'tracker activity add')", name)
+	}
+	if activity.Archived {
+		return models.Activity{}, fmt.Errorf("activity %s is archived (restore it with 'tracker activity archive %s --restore')", activity.Name, activity.Name)
 	}
 
-	return nil
+	return *activity, nil
+}
+
+// parseEffort reads --intensity or --rpe; only one may be given
+func parseEffort(cmd *cobra.Command) (models.Intensity, int, error) {
+	if cmd.Flags().Changed("intensity") && cmd.Flags().Changed("rpe") {
+		return "", 0, fmt.Errorf("cannot use both --intensity and --rpe")
+	}
+	if cmd.Flags().Changed("rpe") {
+		if flags.rpe < 1 || flags.rpe > 10 {
+			return "", 0, fmt.Errorf("RPE must be between 1 and 10")
+		}
+		return "", flags.rpe, nil
+	}
+	if cmd.Flags().Changed("intensity") {
+		intensity, err := models.ParseIntensity(flags.intensity)
+		return intensity, 0, err
+	}
+	return "", 0, nil
+}
+
+// parseDistance reads --distance and --elevation in the --unit system and
+// converts them to miles and feet. Distance is only tracked for cardio.
+func parseDistance(cmd *cobra.Command, activity models.Activity) (float64, float64, error) {
+	if !cmd.Flags().Changed("distance") && !cmd.Flags().Changed("elevation") {
+		return 0, 0, nil
+	}
+	if activity.Category != models.CategoryCardio {
+		return 0, 0, fmt.Errorf("distance is only tracked for cardio activities, not %s (%s)", activity.Name, activity.Category)
+	}
+	unit, err := models.ParseDistanceUnit(flags.unit)
+	if err != nil {
+		return 0, 0, err
+	}
+	return unit.ToMiles(flags.distance), unit.ToFeet(flags.elevation), nil
+}
+
+// confirmPace warns about an implausible pace and asks whether to keep it
+func confirmPace(record models.ExerciseRecord) bool {
+	if err := record.CheckPace(); err != nil {
+		display.ShowWarning("%s", err.Error())
+		return display.ConfirmAction("Do you want to continue?").Confirmed
+	}
+	return true
+}
+
+// estimateCalories returns a calorie estimate per record (nil when no weight
+// is available) and the total across records, nil when a done session has no
+// estimate
+func estimateCalories(store storage.StorageManager, records []models.ExerciseRecord) ([]*float64, *float64, error) {
+	estimator, err := calories.NewEstimator(store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimates := make([]*float64, len(records))
+	total := 0.0
+	known := true
+	for i, record := range records {
+		if record.IsOutstanding() {
+			continue // nothing burned yet
+		}
+		estimate, err := estimator.Estimate(record)
+		if err != nil {
+			return nil, nil, err
+		}
+		if estimate == nil {
+			known = false
+			continue
+		}
+		estimates[i] = &estimate.Calories
+		total += estimate.Calories
+	}
+	if !known {
+		return estimates, nil, nil
+	}
+	return estimates, &total, nil
+}
+
+// formatTotalCalories shows a calorie total, or that it needs a weigh-in
+func formatTotalCalories(total *float64) string {
+	if total == nil {
+		return "unknown (log a weight)"
+	}
+	return fmt.Sprintf("%.0f kcal", *total)
 }
 
 func validateDuration(duration int) error {
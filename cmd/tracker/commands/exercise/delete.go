@@ -13,36 +13,36 @@ import (
 
 func newDeleteCmd(store storage.StorageManager) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete",
+		Use:   "delete [record-id]",
 		Short: "Delete an exercise record",
+		Args:  cobra.ExactArgs(1),
 		RunE:  createDeleteCmdRunner(store),
 	}
 
-	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of exercise record to delete (required)")
-	cmd.MarkFlagRequired("date")
-
 	return cmd
 }
 
 func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		// Parse and validate date
-		date, err := validator.ParseDate(flags.date)
-		if err != nil {
+		recordID := args[0]
+
+		// Validate record ID format
+		if err := validateExerciseID(recordID); err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
 		// Get record to show confirmation
-		record, err := store.GetExercise(date)
+		record, err := store.GetExerciseByID(recordID)
 		if err != nil {
 			return result.StorageError(err).Error
 		}
 		if record == nil {
-			return result.NotFound("Exercise record", flags.date).Error
+			return result.NotFound("Exercise record", recordID).Error
 		}
 
 		// Show confirmation with record details
 		confirmResult := display.ShowExerciseDeleteConfirmation(
+			record.ID,
 			record.Date.Format(validator.DateFormat),
 			string(record.Activity),
 			record.OtherActivity,
@@ -56,7 +56,7 @@ func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 			return result.NewError(fmt.Errorf("operation cancelled")).Error
 		}
 
-		if err := store.DeleteExercise(date); err != nil {
+		if err := store.DeleteExercise(recordID); err != nil {
 			return result.StorageError(err).Error
 		}
 
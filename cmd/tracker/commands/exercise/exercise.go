@@ -9,13 +9,33 @@ import (
 // Shared flags across exercise commands
 type exerciseFlags struct {
 	// Basic flags for add/update
-	activity      string
-	otherActivity string
-	duration      int
-	date          string
-	notes         string
-	completed     bool
-	notCompleted  bool
+	activity     string
+	duration     int
+	date         string
+	notes        string
+	completed    bool
+	notCompleted bool
+	intensity    string
+	rpe          int
+	distance     float64
+	elevation    float64
+	unit         string
+	gear         string
+
+	// Load command flags
+	weeks int
+
+	// Import command flags
+	dryRun bool
+
+	// Goal command flags
+	dailyMinutes    int
+	weeklyMinutes   int
+	weeklyIntensity string
+	sessionsPerWeek int
+	targets         []string
+	countIncomplete bool
+	effectiveFrom   string
 
 	// List command flags
 	fromDate  string
@@ -37,11 +57,51 @@ Examples:
   # Add an exercise record
   tracker exercise add --activity jogging --duration 45 --date 2024-01-08 --notes "Morning run"
 
-  # Add other activity type
-  tracker exercise add --activity other --other-activity "swimming" --duration 30
+  # Activities come from the catalog and can be given by alias
+  tracker activity add swimming --category cardio --alias swim
+  tracker exercise add --activity swim --duration 30
 
   # Add with completion status
-  tracker exercise add --activity cycling --duration 60 --completed`,
+  tracker exercise add --activity cycling --duration 60 --completed
+
+  # Record effort as a level or an RPE for calorie estimates
+  tracker exercise add --activity jogging --duration 30 --intensity vigorous
+  tracker exercise add --activity walking --duration 60 --rpe 4
+
+  # Record distance and elevation for endurance activities
+  tracker exercise add --activity jogging --duration 42 --distance 5 --elevation 300
+  tracker exercise add --activity cycling --duration 90 --distance 40 --unit km
+
+  # Link a session to gear; without --gear the activity's default gear is used
+  tracker exercise add --activity jogging --duration 45 --distance 5 --gear "Trail Shoes"
+
+  # Import a recorded ride, previewing it first
+  tracker exercise import ride.gpx --dry-run
+  tracker exercise import ride.gpx
+
+  # Log a second session on the same day
+  tracker exercise add --activity pickleball --duration 90 --date 2024-01-08 --completed
+
+  # Show all sessions on a day, or one session by ID
+  tracker exercise get --date 2024-01-08
+  tracker exercise get e00001
+
+  # Aim for 30 minutes a day and 150 moderate minutes a week
+  tracker exercise goal set --daily 30 --weekly 150 --weekly-intensity moderate
+
+  # Plan a session ahead and mark it done later
+  tracker exercise plan add --activity jogging --duration 60 --date 2024-01-13
+  tracker exercise plan done e00012 --duration 55
+
+  # Show personal bests
+  tracker exercise records
+
+  # Check whether training load is ramping up too fast
+  tracker exercise load
+
+  # Update or delete a session by ID
+  tracker exercise update e00001 --duration 50
+  tracker exercise delete e00002`,
 	}
 
 	// Add subcommands
@@ -51,6 +111,11 @@ Examples:
 		newListCmd(store),
 		newUpdateCmd(store),
 		newDeleteCmd(store),
+		newGoalCmd(store),
+		newImportCmd(store),
+		newRecordsCmd(store),
+		newLoadCmd(store),
+		newPlanCmd(store),
 		// Additional commands will be added here
 	)
 
@@ -66,12 +131,17 @@ func newAddCmd(store storage.StorageManager) *cobra.Command {
 	}
 
 	// Add flags
-	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Type of exercise activity (required)")
-	cmd.Flags().StringVarP(&flags.otherActivity, "other-activity", "o", "", "Name of activity when type is 'other'")
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Activity name or alias from the catalog (required)")
 	cmd.Flags().IntVarP(&flags.duration, "duration", "d", 0, "Duration in minutes (required)")
 	cmd.Flags().StringVarP(&flags.date, "date", "t", "", "Date of exercise (default: today)")
 	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the exercise")
 	cmd.Flags().BoolVarP(&flags.completed, "completed", "c", false, "Mark exercise as completed")
+	cmd.Flags().StringVarP(&flags.intensity, "intensity", "i", "", "Effort: light, moderate or vigorous")
+	cmd.Flags().IntVarP(&flags.rpe, "rpe", "r", 0, "Rate of perceived exertion from 1 to 10 (instead of --intensity)")
+	cmd.Flags().Float64Var(&flags.distance, "distance", 0, "Distance covered, for cardio activities")
+	cmd.Flags().Float64Var(&flags.elevation, "elevation", 0, "Elevation gain (feet, or meters with --unit km)")
+	cmd.Flags().StringVar(&flags.unit, "unit", "mi", "Distance unit: mi or km")
+	cmd.Flags().StringVar(&flags.gear, "gear", "", "Gear ID or name, or none (default: the activity's default gear)")
 
 	cmd.MarkFlagRequired("activity")
 	cmd.MarkFlagRequired("duration")
@@ -0,0 +1,80 @@
+// cmd/tracker/commands/exercise/gear.go
+package exercise
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// noGear is the --gear value that links a session to no gear
+const noGear = "none"
+
+// resolveGear returns the ID of the gear to link a session to: --gear by ID or
+// name, none for no gear, or the activity's default gear without the flag
+func resolveGear(cmd *cobra.Command, store storage.StorageManager, activity models.ActivityType) (string, error) {
+	list, err := store.GetGear()
+	if err != nil {
+		return "", err
+	}
+
+	if !cmd.Flags().Changed("gear") {
+		if gear := list.DefaultFor(activity); gear != nil {
+			return gear.ID, nil
+		}
+		return "", nil
+	}
+
+	if strings.EqualFold(flags.gear, noGear) {
+		return "", nil
+	}
+	gear, err := list.Find(flags.gear)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case gear == nil:
+		return "", fmt.Errorf("gear not found: %s (see 'tracker gear list')", flags.gear)
+	case gear.Retired:
+		return "", fmt.Errorf("%s is retired (restore it with 'tracker gear retire %s --restore')", gear.Name, gear.ID)
+	}
+	return gear.ID, nil
+}
+
+// updatedGear returns the gear for an updated session. Without --gear, gear
+// used for another activity is replaced by the new activity's default.
+func updatedGear(cmd *cobra.Command, store storage.StorageManager, record models.ExerciseRecord) (string, error) {
+	if !cmd.Flags().Changed("gear") && record.GearID != "" {
+		gear, err := store.GetGearByID(record.GearID)
+		if err != nil {
+			return "", err
+		}
+		if gear != nil && gear.Activity == record.Activity {
+			return record.GearID, nil
+		}
+	}
+	return resolveGear(cmd, store, record.Activity)
+}
+
+// gearMessages describes the usage of a session's gear and, when it has passed
+// its retirement threshold, returns a warning. Both are empty without gear.
+func gearMessages(store storage.StorageManager, gearID string) ([]string, string, error) {
+	if gearID == "" {
+		return nil, "", nil
+	}
+	gear, err := store.GetGearByID(gearID)
+	if err != nil || gear == nil {
+		return nil, "", err
+	}
+
+	records, err := store.GetExerciseRange(time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), false)
+	if err != nil {
+		return nil, "", err
+	}
+	usage := models.ComputeGearUsage(*gear, records)
+	return []string{usage.String()}, usage.RetirementWarning(), nil
+}
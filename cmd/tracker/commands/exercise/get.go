@@ -2,8 +2,11 @@
 package exercise
 
 import (
+	"fmt"
+
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/jack-sneddon/my-health-tracker/internal/validator"
 	"github.com/spf13/cobra"
@@ -11,39 +14,76 @@ import (
 
 func newGetCmd(store storage.StorageManager) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get",
-		Short: "Get exercise record for a specific date",
+		Use:   "get [record-id]",
+		Short: "Get an exercise record by ID, or all sessions on a date",
+		Args:  cobra.MaximumNArgs(1),
 		RunE:  createGetCmdRunner(store),
 	}
 
-	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date to get exercise record for (required)")
-	cmd.MarkFlagRequired("date")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date to get exercise sessions for")
 
 	return cmd
 }
 
 func createGetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			recordID := args[0]
+			if err := validateExerciseID(recordID); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+
+			record, err := store.GetExerciseByID(recordID)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			if record == nil {
+				return result.NotFound("Exercise record", recordID).Error
+			}
+
+			display.ShowCommandResult(result.NewSuccess(*record, "Found exercise record"))
+			return nil
+		}
+
+		if flags.date == "" {
+			return result.ValidationFailed(fmt.Errorf("specify a record ID or --date")).Error
+		}
+
 		// Parse and validate date
 		date, err := validator.ParseDate(flags.date)
 		if err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
-		// Get record from storage
-		record, err := store.GetExercise(date)
+		// Get the day's sessions from storage
+		records, err := store.GetExerciseRange(date, date, false)
 		if err != nil {
 			return result.StorageError(err).Error
 		}
 
 		// Handle not found
-		if record == nil {
+		if len(records) == 0 {
 			return result.NotFound("Exercise record", flags.date).Error
 		}
 
-		// Create success result and display
-		cmdResult := result.NewSuccess(*record, "Found exercise record")
-		display.ShowCommandResult(cmdResult)
+		goal, err := goalFor(store, date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// A single session is shown in full
+		if len(records) == 1 {
+			display.ShowCommandResult(result.NewSuccess(records[0], "Found exercise record", dailySummary(records, goal)))
+			return nil
+		}
+
+		display.ShowHeader(fmt.Sprintf("Exercise Sessions on %s", flags.date))
+		estimates, _, err := estimateCalories(store, records)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		display.ShowExerciseList(records, estimates, models.Miles)
+		display.ShowCommandResult(result.NewSuccess(nil, dailySummary(records, goal)))
 
 		return nil
 	}
@@ -0,0 +1,206 @@
+// cmd/tracker/commands/exercise/goal.go
+package exercise
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newGoalCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "goal",
+		Short: "Show or change the exercise goals",
+		Long: `Show or change the exercise goals.
+
+The daily goal is the number of minutes needed each day. Weekly goals cover
+Monday-Sunday: total minutes (optionally only at or above an intensity),
+number of sessions and minutes for individual activities. A goal of 0 is not
+tracked. Only completed sessions count unless --count-incomplete is set.
+
+Each goal takes effect from a date, so earlier records are still judged against
+the goals that applied at the time.
+
+Examples:
+  # Show the goals in force today and the goal history
+  tracker exercise goal show
+
+  # 30 minutes a day and 150 moderate or vigorous minutes a week
+  tracker exercise goal set --daily 30 --weekly 150 --weekly-intensity moderate
+
+  # Four sessions a week including 90 minutes of jogging, from a given date
+  tracker exercise goal set --sessions 4 --target jogging=90 --from 2024-02-01
+
+  # Stop tracking the jogging target
+  tracker exercise goal set --target jogging=0`,
+	}
+
+	cmd.AddCommand(
+		newGoalShowCmd(store),
+		newGoalSetCmd(store),
+	)
+
+	return cmd
+}
+
+func newGoalShowCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the current exercise goals",
+		RunE:  createGoalShowCmdRunner(store),
+	}
+}
+
+func newGoalSetCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the exercise goals from a given date",
+		RunE:  createGoalSetCmdRunner(store),
+	}
+
+	cmd.Flags().IntVar(&flags.dailyMinutes, "daily", 0, "Minutes needed each day")
+	cmd.Flags().IntVar(&flags.weeklyMinutes, "weekly", 0, "Minutes needed each week")
+	cmd.Flags().StringVar(&flags.weeklyIntensity, "weekly-intensity", "", "Least intensity counted toward weekly minutes: light, moderate, vigorous or any")
+	cmd.Flags().IntVar(&flags.sessionsPerWeek, "sessions", 0, "Sessions needed each week")
+	cmd.Flags().StringArrayVar(&flags.targets, "target", nil, "Weekly minutes for an activity as ACTIVITY=MINUTES (repeatable, 0 removes)")
+	cmd.Flags().BoolVar(&flags.countIncomplete, "count-incomplete", false, "Count sessions not marked completed toward the goals")
+	cmd.Flags().StringVarP(&flags.effectiveFrom, "from", "f", "", "Date the goals take effect (default: today)")
+
+	return cmd
+}
+
+func createGoalShowCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowExerciseGoal(settings.ExerciseGoals.GoalFor(time.Now()))
+
+		if len(settings.ExerciseGoals) > 0 {
+			display.ShowHeader("Goal History:")
+			for _, goal := range settings.ExerciseGoals {
+				fmt.Printf("  %s  %d min/day, %d min/week, %d sessions/week\n",
+					goal.EffectiveFrom.Format(validator.DateFormat),
+					goal.DailyMinutes, goal.WeeklyMinutes, goal.SessionsPerWeek)
+			}
+		}
+
+		return nil
+	}
+}
+
+func createGoalSetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		effectiveFrom, err := validator.ParseDate(flags.effectiveFrom)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		effectiveFrom = time.Date(effectiveFrom.Year(), effectiveFrom.Month(), effectiveFrom.Day(), 0, 0, 0, 0, time.UTC)
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		changed := false
+		for _, name := range []string{"daily", "weekly", "weekly-intensity", "sessions", "target", "count-incomplete"} {
+			changed = changed || cmd.Flags().Changed(name)
+		}
+		if !changed {
+			return result.ValidationFailed(fmt.Errorf("specify at least one goal to change")).Error
+		}
+
+		// Start from the goals currently in force on that date
+		current := settings.ExerciseGoals.GoalFor(effectiveFrom)
+		goal := current
+		goal.EffectiveFrom = effectiveFrom
+		goal.ActivityTargets = make(map[models.ActivityType]int)
+		for activity, minutes := range current.ActivityTargets {
+			goal.ActivityTargets[activity] = minutes
+		}
+
+		if cmd.Flags().Changed("daily") {
+			goal.DailyMinutes = flags.dailyMinutes
+		}
+		if cmd.Flags().Changed("weekly") {
+			goal.WeeklyMinutes = flags.weeklyMinutes
+		}
+		if cmd.Flags().Changed("weekly-intensity") {
+			goal.WeeklyIntensity = ""
+			if flags.weeklyIntensity != "any" {
+				goal.WeeklyIntensity, err = models.ParseIntensity(flags.weeklyIntensity)
+				if err != nil {
+					return result.ValidationFailed(err).Error
+				}
+			}
+		}
+		if cmd.Flags().Changed("sessions") {
+			goal.SessionsPerWeek = flags.sessionsPerWeek
+		}
+		if cmd.Flags().Changed("count-incomplete") {
+			goal.CountIncomplete = flags.countIncomplete
+		}
+
+		catalog := settings.GetActivityCatalog()
+		for _, target := range flags.targets {
+			activity, minutes, err := parseTarget(catalog, target)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			if minutes == 0 {
+				delete(goal.ActivityTargets, activity)
+			} else {
+				goal.ActivityTargets[activity] = minutes
+			}
+		}
+		if len(goal.ActivityTargets) == 0 {
+			goal.ActivityTargets = nil
+		}
+
+		if err := goal.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.ExerciseGoals = settings.ExerciseGoals.Add(goal)
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil, fmt.Sprintf("Exercise goals updated from %s",
+			effectiveFrom.Format(validator.DateFormat))))
+		display.ShowExerciseGoal(goal)
+
+		return nil
+	}
+}
+
+// parseTarget reads an ACTIVITY=MINUTES weekly target, resolving the activity
+// name or alias against the catalog
+func parseTarget(catalog models.ActivityCatalog, target string) (models.ActivityType, int, error) {
+	name, value, ok := strings.Cut(target, "=")
+	if !ok {
+		return "", 0, fmt.Errorf("invalid target: %s (use ACTIVITY=MINUTES)", target)
+	}
+
+	activity := catalog.Find(strings.TrimSpace(name))
+	if activity == nil {
+		return "", 0, fmt.Errorf("invalid activity type: %s (see 'tracker activity list')", name)
+	}
+
+	minutes, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || minutes < 0 {
+		return "", 0, fmt.Errorf("invalid target minutes for %s: %s", activity.Name, value)
+	}
+
+	return activity.Name, minutes, nil
+}
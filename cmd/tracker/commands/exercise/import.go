@@ -0,0 +1,196 @@
+// cmd/tracker/commands/exercise/import.go
+package exercise
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/jack-sneddon/my-health-tracker/internal/workout"
+	"github.com/spf13/cobra"
+)
+
+// sportActivities maps activity types written by common devices and apps to
+// default catalog activities, for names the catalog doesn't know as aliases
+var sportActivities = map[models.ActivityType]models.ActivityType{
+	"ride":              models.Cycling,
+	"virtualride":       models.Cycling,
+	"road_biking":       models.Cycling,
+	"mountainbikeride":  models.MountainBike,
+	"mountain_bike":     models.MountainBike,
+	"trail_running":     models.Jogging,
+	"trailrun":          models.Jogging,
+	"virtualrun":        models.Jogging,
+	"hike":              models.Walking,
+	"hiking":            models.Walking,
+	"alpineski":         models.Skiing,
+	"alpine_skiing":     models.Skiing,
+	"backcountryski":    models.Skiing,
+	"nordicski":         models.Skiing,
+	"cross_country_ski": models.Skiing,
+}
+
+func newImportCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import a session from a GPX or TCX file",
+		Long: `Import a session from a GPX or TCX file.
+
+The start time, duration, distance and elevation gain are read from the file.
+The activity comes from the file when the device recorded one, or from
+--activity. Imported sessions are marked completed, and importing the same
+file twice is rejected as a duplicate.
+
+Examples:
+  # Preview what would be recorded
+  tracker exercise import ride.gpx --dry-run
+
+  # Import a file that doesn't name its activity
+  tracker exercise import morning.gpx --activity jogging --rpe 6`,
+		Args: cobra.ExactArgs(1),
+		RunE: createImportCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Activity name or alias (default: from the file)")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the exercise")
+	cmd.Flags().StringVarP(&flags.intensity, "intensity", "i", "", "Effort: light, moderate or vigorous")
+	cmd.Flags().IntVarP(&flags.rpe, "rpe", "r", 0, "Rate of perceived exertion from 1 to 10 (instead of --intensity)")
+	cmd.Flags().StringVar(&flags.gear, "gear", "", "Gear ID or name, or none (default: the activity's default gear)")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "Show the record without saving it")
+
+	return cmd
+}
+
+func createImportCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		w, err := workout.ParseFile(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if w.Minutes() <= 0 {
+			return result.ValidationFailed(fmt.Errorf("workout is shorter than a minute")).Error
+		}
+
+		activity, err := importActivity(store, w.Sport)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		intensity, rpe, err := parseEffort(cmd)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		gearID, err := resolveGear(cmd, store, activity.Name)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		record := models.ExerciseRecord{
+			Date:      models.CalendarDate(w.Start),
+			Activity:  activity.Name,
+			Duration:  w.Minutes(),
+			Intensity: intensity,
+			RPE:       rpe,
+			Notes:     flags.notes,
+			Completed: true,
+			GearID:    gearID,
+			Source: &models.ImportSource{
+				File:  filepath.Base(args[0]),
+				Hash:  w.Hash,
+				Start: w.Start,
+			},
+		}
+
+		// Distance is only tracked for cardio, as with manual entries
+		if activity.Category == models.CategoryCardio {
+			record.Distance = w.Distance
+			if w.Distance > 0 {
+				record.ElevationGain = w.ElevationGain
+			}
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// The same file always produces the same start date, so only that day needs checking
+		sameDay, err := store.GetExerciseRange(record.Date, record.Date, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		for _, existing := range sameDay {
+			if existing.Source != nil && existing.Source.Hash == w.Hash {
+				return result.ValidationFailed(fmt.Errorf("%s was already imported as %s",
+					record.Source.File, existing.ID)).Error
+			}
+		}
+
+		started := fmt.Sprintf("Started at %s", w.Start.In(time.Local).Format(validator.DateTimeFormat))
+		if flags.dryRun {
+			display.ShowCommandResult(result.NewSuccess(record, "Dry run: record not saved", started))
+			return nil
+		}
+
+		if !confirmPace(record) {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		record, err = store.AddExercise(record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		goal, err := goalFor(store, record.Date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		sameDay = append(sameDay, record)
+
+		messages := []string{fmt.Sprintf("Imported %s", record.Source.File), started, dailySummary(sameDay, goal)}
+		bests, err := newBestMessages(store, record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, bests...)
+
+		gear, warning, err := gearMessages(store, record.GearID)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, gear...)
+
+		display.ShowCommandResult(result.NewSuccess(record, messages...))
+		if warning != "" {
+			display.ShowWarning("%s", warning)
+		}
+
+		return nil
+	}
+}
+
+// importActivity resolves the activity from --activity, or from the type
+// recorded in the file
+func importActivity(store storage.StorageManager, sport string) (models.Activity, error) {
+	if flags.activity != "" {
+		return resolveActivity(store, flags.activity)
+	}
+	if sport == "" {
+		return models.Activity{}, fmt.Errorf("the file doesn't record an activity type, use --activity")
+	}
+
+	activity, err := resolveActivity(store, sport)
+	if err == nil {
+		return activity, nil
+	}
+	if name, ok := sportActivities[models.NormalizeActivityName(sport)]; ok {
+		return resolveActivity(store, string(name))
+	}
+	return models.Activity{}, fmt.Errorf("unknown activity type in file: %s, use --activity", sport)
+}
@@ -3,6 +3,8 @@ package exercise
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
@@ -18,6 +20,8 @@ type exerciseStats struct {
 	TotalDuration    int
 	AverageDuration  float64
 	CompletedRecords int
+	ActiveDays       int
+	CompliantDays    int
 }
 
 func newListCmd(store storage.StorageManager) *cobra.Command {
@@ -31,6 +35,7 @@ func newListCmd(store storage.StorageManager) *cobra.Command {
 	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing exercises")
 	cmd.Flags().BoolVarP(&flags.lastWeek, "week", "w", false, "Show last 7 days")
 	cmd.Flags().BoolVarP(&flags.lastMonth, "month", "m", false, "Show last month")
+	cmd.Flags().StringVar(&flags.unit, "unit", "mi", "Distance unit: mi or km")
 
 	return cmd
 }
@@ -40,9 +45,13 @@ func newListCmd(store storage.StorageManager) *cobra.Command {
 func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		var fromDate, toDate time.Time
-		var err error
 		var isDefaultRange bool
 
+		unit, err := models.ParseDistanceUnit(flags.unit)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
 		// Handle date range selection
 		switch {
 		case flags.lastWeek:
@@ -94,43 +103,207 @@ func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []st
 				toDate.Format(validator.DateFormat))).Error
 		}
 
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
 		// Calculate statistics
-		stats := calculateExerciseStats(records)
+		stats := calculateExerciseStats(records, settings.ExerciseGoals)
 
 		// Display results
 		display.ShowHeader(fmt.Sprintf("Exercise Records from %s to %s",
 			fromDate.Format(validator.DateFormat),
 			toDate.Format(validator.DateFormat)))
 
-		display.ShowExerciseList(records)
+		estimates, totalCalories, err := estimateCalories(store, records)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		display.ShowExerciseList(records, estimates, unit)
+
+		// Group sessions by catalog activity and category
+		showActivityBreakdown(records, settings.GetActivityCatalog(), unit)
+
+		// Weekly targets are judged on whole weeks, so load the full weeks around
+		// the requested range, even when the default range matched other years.
+		// Weeks in the range without sessions count as off target.
+		weekFrom, weekTo := models.WeekStart(fromDate), models.WeekStart(toDate).AddDate(0, 0, 6)
+		weekRecords, err := store.GetExerciseRange(weekFrom, weekTo, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		weeks := models.GroupExerciseByWeek(weekRecords, settings.ExerciseGoals, weekFrom, weekTo)
+		showWeeklyProgress(weeks)
 
-		display.ShowStats(map[string]string{
+		summary := map[string]string{
+			"Total Calories":    formatTotalCalories(totalCalories),
+			"Active Days":       fmt.Sprintf("%d", stats.ActiveDays),
+			"Compliant Days":    fmt.Sprintf("%d", stats.CompliantDays),
 			"Average Duration":  fmt.Sprintf("%.1f minutes", stats.AverageDuration),
 			"Completed Records": fmt.Sprintf("%d", stats.CompletedRecords),
 			"Completion Rate":   fmt.Sprintf("%.1f%%", float64(stats.CompletedRecords)/float64(stats.TotalRecords)*100),
 			"Total Records":     fmt.Sprintf("%d", stats.TotalRecords),
 			"Total Duration":    fmt.Sprintf("%d minutes", stats.TotalDuration),
-		})
+		}
+		if tracked, met := weeklyCompliance(weeks); tracked > 0 {
+			summary["Weeks On Target"] = fmt.Sprintf("%d of %d", met, tracked)
+		}
+		display.ShowStats(summary)
 
 		return nil
 	}
 }
 
-func calculateExerciseStats(records []models.ExerciseRecord) exerciseStats {
+func calculateExerciseStats(records []models.ExerciseRecord, goals models.ExerciseGoals) exerciseStats {
 	stats := exerciseStats{
 		TotalRecords: len(records),
 	}
 
+	sessions := 0
 	for _, record := range records {
-		stats.TotalDuration += record.Duration
 		if record.Completed {
 			stats.CompletedRecords++
 		}
+		// Plans that haven't been done add no minutes
+		if !record.IsOutstanding() {
+			stats.TotalDuration += record.Duration
+			sessions++
+		}
+	}
+
+	// Compliance is judged on each day's total against the goal in force that day
+	for _, day := range models.GroupExerciseByDay(records) {
+		if day.Sessions > 0 {
+			stats.ActiveDays++
+		}
+		if goals.GoalFor(day.Date).MeetsDaily(day) {
+			stats.CompliantDays++
+		}
 	}
 
-	if stats.TotalRecords > 0 {
-		stats.AverageDuration = float64(stats.TotalDuration) / float64(stats.TotalRecords)
+	if sessions > 0 {
+		stats.AverageDuration = float64(stats.TotalDuration) / float64(sessions)
 	}
 
 	return stats
 }
+
+// showActivityBreakdown totals sessions, minutes and distance per activity,
+// showing distances in the given unit
+func showActivityBreakdown(records []models.ExerciseRecord, catalog models.ActivityCatalog, unit models.DistanceUnit) {
+	type activityTotals struct {
+		sessions        int
+		minutes         int
+		distance        float64
+		distanceMinutes int // minutes of sessions that recorded a distance
+		elevation       float64
+	}
+	var order []models.ActivityType
+	totals := make(map[models.ActivityType]*activityTotals)
+	for _, record := range records {
+		if record.IsOutstanding() {
+			continue
+		}
+		if totals[record.Activity] == nil {
+			order = append(order, record.Activity)
+			totals[record.Activity] = &activityTotals{}
+		}
+		t := totals[record.Activity]
+		t.sessions++
+		t.minutes += record.Duration
+		if record.Distance > 0 {
+			t.distance += record.Distance
+			t.distanceMinutes += record.Duration
+			t.elevation += record.ElevationGain
+		}
+	}
+	sort.Slice(order, func(i, j int) bool { return totals[order[i]].minutes > totals[order[j]].minutes })
+
+	rows := make([][]string, len(order))
+	for i, name := range order {
+		t := totals[name]
+		category := "-"
+		if activity := catalog.Find(string(name)); activity != nil {
+			category = string(activity.Category)
+		}
+		distance, pace, elevation := "-", "-", "-"
+		if t.distance > 0 {
+			distance = unit.FormatDistance(t.distance)
+			pace = unit.FormatPace(float64(t.distanceMinutes) / t.distance)
+			elevation = unit.FormatElevation(t.elevation)
+		}
+		rows[i] = []string{
+			string(name),
+			category,
+			fmt.Sprintf("%d", t.sessions),
+			fmt.Sprintf("%d", t.minutes),
+			distance,
+			pace,
+			elevation,
+		}
+	}
+
+	display.ShowHeader("By Activity")
+	display.ShowTable([]string{"Activity", "Category", "Sessions", "Minutes", "Distance", "Avg Pace", "Elevation"}, rows)
+}
+
+// showWeeklyProgress reports each week's totals against the weekly targets in
+// force at the start of that week. Weeks without weekly targets are skipped.
+func showWeeklyProgress(weeks []models.ExerciseWeek) {
+	var rows [][]string
+	for _, week := range weeks {
+		if !week.Goal.HasWeeklyTargets() {
+			continue
+		}
+
+		minutes := fmt.Sprintf("%d", week.Minutes)
+		if week.Goal.WeeklyMinutes > 0 {
+			minutes = fmt.Sprintf("%d/%d", week.Minutes, week.Goal.WeeklyMinutes)
+		}
+		sessions := fmt.Sprintf("%d", week.Sessions)
+		if week.Goal.SessionsPerWeek > 0 {
+			sessions = fmt.Sprintf("%d/%d", week.Sessions, week.Goal.SessionsPerWeek)
+		}
+		if week.Missed > 0 {
+			sessions = fmt.Sprintf("%s (%d missed)", sessions, week.Missed)
+		}
+
+		var activities []string
+		for activity, target := range week.Goal.ActivityTargets {
+			activities = append(activities, fmt.Sprintf("%s %d/%d", activity, week.ActivityMinutes[activity], target))
+		}
+		sort.Strings(activities)
+		targets := "-"
+		if len(activities) > 0 {
+			targets = strings.Join(activities, ", ")
+		}
+
+		status := "✓"
+		if !week.IsCompliant() {
+			status = "✗"
+		}
+
+		rows = append(rows, []string{week.Start.Format(validator.DateFormat), minutes, sessions, targets, status})
+	}
+	if len(rows) == 0 {
+		return
+	}
+
+	display.ShowHeader("Weekly Goals")
+	display.ShowTable([]string{"Week Of", "Minutes", "Sessions", "Activity Targets", "On Target"}, rows)
+}
+
+// weeklyCompliance counts the weeks with weekly targets and how many met them
+func weeklyCompliance(weeks []models.ExerciseWeek) (tracked, met int) {
+	for _, week := range weeks {
+		if !week.Goal.HasWeeklyTargets() {
+			continue
+		}
+		tracked++
+		if week.IsCompliant() {
+			met++
+		}
+	}
+	return tracked, met
+}
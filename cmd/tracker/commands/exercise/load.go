@@ -0,0 +1,153 @@
+// cmd/tracker/commands/exercise/load.go
+package exercise
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+// ratioJump is the week-on-week rise in the ratio that gets its own warning
+const ratioJump = 0.3
+
+func newLoadCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load",
+		Short: "Show training load and the acute:chronic workload ratio",
+		Long: `Show training load and the acute:chronic workload ratio.
+
+Each completed session's load is its duration times its RPE, with light,
+moderate and vigorous sessions counted as RPE 3, 5 and 8 when no RPE was given.
+
+  Acute load    total load over the last 7 days
+  Chronic load  average weekly load over the last 28 days
+  Ratio         acute divided by chronic; 0.8-1.3 is the usual target, and
+                above 1.5 load is rising faster than the body adapts
+  Monotony      average daily load over its standard deviation for the last
+                7 days; above 2.0 there are too few easy days
+  Strain        acute load times monotony
+
+Examples:
+  # Load as of today with the last four weeks for comparison
+  tracker exercise load
+
+  # Load leading up to a race
+  tracker exercise load --date 2024-04-20 --weeks 8`,
+		RunE: createLoadCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "t", "", "Date to measure load as of (default: today)")
+	cmd.Flags().IntVarP(&flags.weeks, "weeks", "w", 4, "Number of weeks to show in the trend")
+
+	return cmd
+}
+
+func createLoadCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		asOf, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if flags.date == "" {
+			asOf = models.CalendarDate(asOf)
+		}
+		if flags.weeks < 1 || flags.weeks > 52 {
+			return result.ValidationFailed(fmt.Errorf("weeks must be between 1 and 52")).Error
+		}
+
+		// All history up to the date, so the length of history is known
+		records, err := store.GetExerciseRange(time.Time{}, asOf, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// One measurement per week, oldest first
+		loads := make([]models.TrainingLoad, flags.weeks)
+		for i := range loads {
+			loads[i] = models.ComputeTrainingLoad(records, asOf.AddDate(0, 0, -7*(flags.weeks-1-i)))
+		}
+		current := loads[len(loads)-1]
+		if current.Days == 0 {
+			return result.NewError(fmt.Errorf("No completed exercise sessions found up to %s",
+				asOf.Format(validator.DateFormat))).Error
+		}
+
+		display.ShowHeader(fmt.Sprintf("Training Load as of %s", asOf.Format(validator.DateFormat)))
+
+		rows := make([][]string, len(loads))
+		for i, load := range loads {
+			rows[i] = []string{
+				load.AsOf.Format(validator.DateFormat),
+				fmt.Sprintf("%.0f", load.Acute),
+				fmt.Sprintf("%.0f", load.Chronic),
+				formatRatio(load),
+				string(load.Zone()),
+				fmt.Sprintf("%.2f", load.Monotony),
+				fmt.Sprintf("%.0f", load.Strain),
+			}
+		}
+		display.ShowTable([]string{"Week Ending", "Acute", "Chronic", "Ratio", "Zone", "Monotony", "Strain"}, rows)
+
+		display.ShowStats(map[string]string{
+			"Acute Load":   fmt.Sprintf("%.0f AU", current.Acute),
+			"Chronic Load": fmt.Sprintf("%.0f AU per week", current.Chronic),
+			"Ratio":        fmt.Sprintf("%s (%s)", formatRatio(current), current.Zone()),
+			"Monotony":     fmt.Sprintf("%.2f", current.Monotony),
+			"Strain":       fmt.Sprintf("%.0f AU", current.Strain),
+		})
+
+		showLoadWarnings(loads)
+
+		return nil
+	}
+}
+
+func formatRatio(load models.TrainingLoad) string {
+	if load.Chronic == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", load.Ratio)
+}
+
+// showLoadWarnings flags injury risk from the latest measurement and from a
+// sharp rise since the week before
+func showLoadWarnings(loads []models.TrainingLoad) {
+	current := loads[len(loads)-1]
+
+	switch current.Zone() {
+	case models.LoadUnknown:
+		if current.Chronic == 0 {
+			display.ShowInfo("No completed sessions in the last 4 weeks: there is no chronic load to compare against")
+		} else {
+			display.ShowInfo("Only %d day(s) of history: the ratio needs at least %d days to be reliable",
+				current.Days, models.MinLoadHistory)
+		}
+	case models.LoadHighRisk:
+		display.ShowWarning("Ratio %.2f is above %.1f: load is ramping up faster than your body can adapt, raising injury risk. Hold or cut volume this week.",
+			current.Ratio, models.HighRiskRatio)
+	case models.LoadCaution:
+		display.ShowWarning("Ratio %.2f is above %.1f: avoid increasing load further this week",
+			current.Ratio, models.CautionRatio)
+	case models.LoadLow:
+		display.ShowInfo("Ratio %.2f is below %.1f: training less than usual, so ramp back up gradually",
+			current.Ratio, models.LowRatio)
+	}
+
+	if len(loads) > 1 {
+		previous := loads[len(loads)-2]
+		if previous.Chronic > 0 && current.Chronic > 0 && current.Ratio-previous.Ratio > ratioJump {
+			display.ShowWarning("Ratio jumped from %.2f to %.2f in a week", previous.Ratio, current.Ratio)
+		}
+	}
+
+	if current.Monotony > models.HighMonotony {
+		display.ShowWarning("Monotony %.2f is above %.1f: add easier or rest days to vary the load",
+			current.Monotony, models.HighMonotony)
+	}
+}
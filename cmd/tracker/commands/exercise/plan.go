@@ -0,0 +1,402 @@
+// cmd/tracker/commands/exercise/plan.go
+package exercise
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newPlanCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Plan sessions ahead and track plan versus actual",
+		Long: `Plan sessions ahead and track plan versus actual.
+
+Planned sessions are exercise records that aren't done yet. Mark them done with
+the actual duration once they happen. A planned session whose date passes
+without being done is missed, and a missed session makes its day and week
+non-compliant.
+
+Examples:
+  # Plan a long run for Saturday
+  tracker exercise plan add --activity jogging --duration 60 --distance 6 --date 2024-01-13
+
+  # Show sessions still to do and any that were missed
+  tracker exercise plan list
+
+  # Mark it done with what actually happened
+  tracker exercise plan done e00012 --duration 55 --distance 6.2 --rpe 6
+
+  # Weekly adherence to the plan
+  tracker exercise plan report --from 2024-01-01 --to 2024-01-31`,
+	}
+
+	cmd.AddCommand(
+		newPlanAddCmd(store),
+		newPlanDoneCmd(store),
+		newPlanListCmd(store),
+		newPlanReportCmd(store),
+	)
+
+	return cmd
+}
+
+func newPlanAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Plan a session for a date",
+		RunE:  createPlanAddCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Activity name or alias from the catalog (required)")
+	cmd.Flags().IntVarP(&flags.duration, "duration", "d", 0, "Planned duration in minutes (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "t", "", "Date of the session, may be in the future (default: today)")
+	cmd.Flags().Float64Var(&flags.distance, "distance", 0, "Planned distance, for cardio activities")
+	cmd.Flags().StringVar(&flags.unit, "unit", "mi", "Distance unit: mi or km")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the session")
+	cmd.Flags().StringVar(&flags.gear, "gear", "", "Gear ID or name, or none (default: the activity's default gear)")
+
+	cmd.MarkFlagRequired("activity")
+	cmd.MarkFlagRequired("duration")
+
+	return cmd
+}
+
+func newPlanDoneCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "done [record-id]",
+		Short: "Mark a planned session done with what actually happened",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createPlanDoneCmdRunner(store),
+	}
+
+	cmd.Flags().IntVarP(&flags.duration, "duration", "d", 0, "Actual duration in minutes (default: as planned)")
+	cmd.Flags().StringVarP(&flags.date, "date", "t", "", "Date it was done, if not the planned date")
+	cmd.Flags().StringVarP(&flags.intensity, "intensity", "i", "", "Effort: light, moderate or vigorous")
+	cmd.Flags().IntVarP(&flags.rpe, "rpe", "r", 0, "Rate of perceived exertion from 1 to 10 (instead of --intensity)")
+	cmd.Flags().Float64Var(&flags.distance, "distance", 0, "Actual distance (default: as planned)")
+	cmd.Flags().Float64Var(&flags.elevation, "elevation", 0, "Elevation gain (feet, or meters with --unit km)")
+	cmd.Flags().StringVar(&flags.unit, "unit", "mi", "Distance unit: mi or km")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Notes about the session")
+
+	return cmd
+}
+
+func newPlanListCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List planned sessions that aren't done yet",
+		RunE:  createPlanListCmdRunner(store),
+	}
+}
+
+func newPlanReportCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show plan versus actual per week",
+		RunE:  createPlanReportCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date (default: four weeks ago)")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date, may be in the future (default: end of this week)")
+
+	return cmd
+}
+
+func createPlanAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		today := models.CalendarDate(time.Now())
+		date := today
+		if flags.date != "" {
+			var err error
+			if date, err = validator.ParseDateAllowingFuture(flags.date); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+
+		activity, err := resolveActivity(store, flags.activity)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := validateDuration(flags.duration); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		distance, _, err := parseDistance(cmd, activity)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		gearID, err := resolveGear(cmd, store, activity.Name)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Until it's done the record carries the planned values
+		record := models.ExerciseRecord{
+			Date:     date,
+			Activity: activity.Name,
+			Duration: flags.duration,
+			Distance: distance,
+			Notes:    flags.notes,
+			GearID:   gearID,
+			Plan: &models.ExercisePlan{
+				Duration: flags.duration,
+				Distance: distance,
+			},
+		}
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		record, err = store.AddExercise(record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(record, fmt.Sprintf("Session planned for %s",
+			date.Format(validator.DateFormat))))
+		if date.Before(today) {
+			display.ShowWarning("%s is in the past: the session counts as missed until it's marked done (log finished sessions with 'tracker exercise add')",
+				date.Format(validator.DateFormat))
+		}
+
+		return nil
+	}
+}
+
+func createPlanDoneCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		recordID := args[0]
+		if err := validateExerciseID(recordID); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		record, err := store.GetExerciseByID(recordID)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if record == nil {
+			return result.NotFound("Exercise record", recordID).Error
+		}
+		if !record.IsPlanned() {
+			return result.ValidationFailed(fmt.Errorf("%s was not a planned session", recordID)).Error
+		}
+		if record.Completed {
+			return result.ValidationFailed(fmt.Errorf("%s is already done", recordID)).Error
+		}
+
+		// Sessions can't be done ahead of time, so a future plan needs the real date
+		if cmd.Flags().Changed("date") {
+			date, err := validator.ParseDate(flags.date)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			if flags.date == "" {
+				date = models.CalendarDate(date)
+			}
+			record.Date = date
+		} else if models.DateOnly(record.Date).After(models.CalendarDate(time.Now())) {
+			return result.ValidationFailed(fmt.Errorf("%s is planned for %s, use --date to record when it was done",
+				recordID, record.Date.Format(validator.DateFormat))).Error
+		}
+
+		if cmd.Flags().Changed("duration") {
+			if err := validateDuration(flags.duration); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.Duration = flags.duration
+		}
+
+		intensity, rpe, err := parseEffort(cmd)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		record.Intensity = intensity
+		record.RPE = rpe
+
+		if cmd.Flags().Changed("distance") || cmd.Flags().Changed("elevation") {
+			activity, err := resolveActivity(store, string(record.Activity))
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			distance, elevation, err := parseDistance(cmd, activity)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			if cmd.Flags().Changed("distance") {
+				record.Distance = distance
+			}
+			record.ElevationGain = elevation
+		}
+
+		if cmd.Flags().Changed("notes") {
+			record.Notes = flags.notes
+		}
+		record.Completed = true
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if !confirmPace(*record) {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		if err := store.UpdateExercise(recordID, *record); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		messages := []string{"Planned session marked done",
+			fmt.Sprintf("%d of %d planned minutes", record.Duration, record.Plan.Duration)}
+		bests, err := newBestMessages(store, *record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, bests...)
+
+		gear, warning, err := gearMessages(store, record.GearID)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		messages = append(messages, gear...)
+
+		display.ShowCommandResult(result.NewSuccess(*record, messages...))
+		if warning != "" {
+			display.ShowWarning("%s", warning)
+		}
+
+		return nil
+	}
+}
+
+func createPlanListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		records, err := store.GetExerciseRange(time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		now := time.Now()
+		var upcoming, missed []models.ExerciseRecord
+		for _, record := range records {
+			switch {
+			case record.IsPending(now):
+				upcoming = append(upcoming, record)
+			case record.IsMissed(now):
+				missed = append(missed, record)
+			}
+		}
+		if len(upcoming) == 0 && len(missed) == 0 {
+			return result.NewError(fmt.Errorf("No planned sessions to do")).Error
+		}
+
+		if len(upcoming) > 0 {
+			sortByDate(upcoming)
+			display.ShowHeader("Upcoming Sessions")
+			display.ShowExerciseList(upcoming, nil, models.Miles)
+		}
+		if len(missed) > 0 {
+			sortByDate(missed)
+			display.ShowHeader("Missed Sessions")
+			display.ShowExerciseList(missed, nil, models.Miles)
+		}
+
+		return nil
+	}
+}
+
+func createPlanReportCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		thisWeek := models.WeekStart(time.Now())
+		fromDate, toDate := thisWeek.AddDate(0, 0, -21), thisWeek.AddDate(0, 0, 6)
+
+		var err error
+		if flags.fromDate != "" {
+			if fromDate, err = validator.ParseDateAllowingFuture(flags.fromDate); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+		if flags.toDate != "" {
+			if toDate, err = validator.ParseDateAllowingFuture(flags.toDate); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+		if fromDate.After(toDate) {
+			return result.ValidationFailed(fmt.Errorf("'from' date must be before 'to' date")).Error
+		}
+
+		records, err := store.GetExerciseRange(fromDate, toDate, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		weeks := models.GroupPlansByWeek(records, time.Now())
+		var rows [][]string
+		var total models.PlanWeek
+		for _, week := range weeks {
+			if week.Planned == 0 {
+				continue
+			}
+			rows = append(rows, []string{
+				week.Start.Format(validator.DateFormat),
+				fmt.Sprintf("%d", week.Planned),
+				fmt.Sprintf("%d", week.Done),
+				fmt.Sprintf("%d", week.Missed),
+				fmt.Sprintf("%d", week.Pending),
+				fmt.Sprintf("%d/%d", week.ActualMinutes, week.PlannedMinutes),
+				formatAdherence(week),
+				fmt.Sprintf("%d", week.Unplanned),
+			})
+			total.Planned += week.Planned
+			total.Done += week.Done
+			total.Missed += week.Missed
+			total.Pending += week.Pending
+			total.PlannedMinutes += week.PlannedMinutes
+			total.ActualMinutes += week.ActualMinutes
+		}
+
+		if len(rows) == 0 {
+			return result.NewError(fmt.Errorf("No planned sessions found between %s and %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat))).Error
+		}
+
+		display.ShowHeader(fmt.Sprintf("Plan vs Actual from %s to %s",
+			fromDate.Format(validator.DateFormat),
+			toDate.Format(validator.DateFormat)))
+		display.ShowTable([]string{"Week Of", "Planned", "Done", "Missed", "Pending", "Minutes", "Adherence", "Unplanned"}, rows)
+
+		display.ShowStats(map[string]string{
+			"Planned Sessions": fmt.Sprintf("%d", total.Planned),
+			"Done":             fmt.Sprintf("%d", total.Done),
+			"Missed":           fmt.Sprintf("%d", total.Missed),
+			"Adherence":        formatAdherence(total),
+			"Minutes Done":     fmt.Sprintf("%d of %d planned", total.ActualMinutes, total.PlannedMinutes),
+		})
+
+		return nil
+	}
+}
+
+// formatAdherence shows the share of due planned sessions that were done
+func formatAdherence(week models.PlanWeek) string {
+	adherence := week.Adherence()
+	if adherence < 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", adherence*100)
+}
+
+func sortByDate(records []models.ExerciseRecord) {
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Date.Before(records[j].Date) })
+}
@@ -0,0 +1,125 @@
+// cmd/tracker/commands/exercise/records.go
+package exercise
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newRecordsCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Short: "Show personal bests from exercise history",
+		Long: `Show personal bests from completed sessions: the longest session, longest
+distance and fastest pace for each activity, the best estimated 1RM for each
+lift, the most minutes in a day and in a week, and the longest run of days
+meeting the daily goal. Fastest pace only counts sessions of a mile or more.`,
+		RunE: createRecordsCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Only show bests for this activity")
+
+	return cmd
+}
+
+func createRecordsCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var subject string
+		if flags.activity != "" {
+			activity, err := resolveActivity(store, flags.activity)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			subject = string(activity.Name)
+		}
+
+		bests, err := personalBests(store, "")
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Overall bests first, then by activity or lift
+		sort.SliceStable(bests, func(i, j int) bool {
+			if bests[i].Subject != bests[j].Subject {
+				return bests[i].Subject < bests[j].Subject
+			}
+			return bests[i].Kind < bests[j].Kind
+		})
+
+		var rows [][]string
+		for _, best := range bests {
+			if subject != "" && best.Subject != subject {
+				continue
+			}
+			who := best.Subject
+			if who == "" {
+				who = "all"
+			}
+			when := best.Date.Format(validator.DateFormat)
+			if !best.Through.IsZero() && !best.Through.Equal(best.Date) {
+				when = fmt.Sprintf("%s to %s", when, best.Through.Format(validator.DateFormat))
+			}
+			session := best.RecordID
+			if session == "" {
+				session = "-"
+			}
+			rows = append(rows, []string{string(best.Kind), who, best.FormatValue(), when, session})
+		}
+
+		if len(rows) == 0 {
+			return result.NewError(fmt.Errorf("No completed exercise sessions found")).Error
+		}
+
+		display.ShowHeader("Personal Bests")
+		display.ShowTable([]string{"Record", "Activity", "Best", "Date", "Session"}, rows)
+
+		return nil
+	}
+}
+
+// personalBests computes bests across all history, leaving out the session
+// with excludeID when it is not empty
+func personalBests(store storage.StorageManager, excludeID string) ([]models.PersonalBest, error) {
+	records, err := store.GetExerciseRange(time.Time{}, time.Now(), false)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := store.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	included := records[:0]
+	for _, record := range records {
+		if record.ID != excludeID {
+			included = append(included, record)
+		}
+	}
+	return models.ComputePersonalBests(included, settings.ExerciseGoals), nil
+}
+
+// newBestMessages describes the personal bests a newly saved session set
+func newBestMessages(store storage.StorageManager, record models.ExerciseRecord) ([]string, error) {
+	before, err := personalBests(store, record.ID)
+	if err != nil {
+		return nil, err
+	}
+	after, err := personalBests(store, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, best := range models.NewPersonalBests(before, after) {
+		messages = append(messages, fmt.Sprintf("New personal best! %s", best))
+	}
+	return messages, nil
+}
@@ -3,6 +3,7 @@ package exercise
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
@@ -14,39 +15,42 @@ import (
 
 func newUpdateCmd(store storage.StorageManager) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update",
+		Use:   "update [record-id]",
 		Short: "Update an exercise record",
+		Args:  cobra.ExactArgs(1),
 		RunE:  createUpdateCmdRunner(store),
 	}
 
-	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the exercise record to update (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Updated date")
 	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Updated activity type")
-	cmd.Flags().StringVarP(&flags.otherActivity, "other-activity", "o", "", "Name of activity when type is 'other'")
 	cmd.Flags().IntVarP(&flags.duration, "duration", "u", 0, "Updated duration in minutes")
 	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Updated notes")
 	cmd.Flags().BoolVarP(&flags.completed, "completed", "c", false, "Mark as completed")
 	cmd.Flags().BoolVar(&flags.notCompleted, "not-completed", false, "Mark as not completed")
-
-	cmd.MarkFlagRequired("date")
+	cmd.Flags().StringVarP(&flags.intensity, "intensity", "i", "", "Updated effort: light, moderate or vigorous")
+	cmd.Flags().IntVarP(&flags.rpe, "rpe", "r", 0, "Updated rate of perceived exertion from 1 to 10")
+	cmd.Flags().Float64Var(&flags.distance, "distance", 0, "Updated distance")
+	cmd.Flags().Float64Var(&flags.elevation, "elevation", 0, "Updated elevation gain (feet, or meters with --unit km)")
+	cmd.Flags().StringVar(&flags.unit, "unit", "mi", "Distance unit: mi or km")
+	cmd.Flags().StringVar(&flags.gear, "gear", "", "Updated gear ID or name, or none")
 
 	return cmd
 }
 
 func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		// Parse and validate date
-		date, err := validator.ParseDate(flags.date)
-		if err != nil {
+		recordID := args[0]
+		if err := validateExerciseID(recordID); err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
 		// Get existing record
-		record, err := store.GetExercise(date)
+		record, err := store.GetExerciseByID(recordID)
 		if err != nil {
 			return result.StorageError(err).Error
 		}
 		if record == nil {
-			return result.NotFound("Exercise record", flags.date).Error
+			return result.NotFound("Exercise record", recordID).Error
 		}
 
 		// Store original values for comparison
@@ -55,12 +59,12 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 
 		// Update fields if provided
 		if cmd.Flags().Changed("activity") {
-			activity := models.ActivityType(flags.activity)
-			if err := validateActivity(activity, flags.otherActivity); err != nil {
+			activity, err := resolveActivity(store, flags.activity)
+			if err != nil {
 				return result.ValidationFailed(err).Error
 			}
-			record.Activity = activity
-			record.OtherActivity = flags.otherActivity
+			record.Activity = activity.Name
+			record.OtherActivity = ""
 		}
 
 		if cmd.Flags().Changed("duration") {
@@ -73,7 +77,7 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 			if flags.duration > originalDuration*2 || flags.duration < originalDuration/2 {
 				message := fmt.Sprintf("Duration change is substantial (from %d to %d minutes)",
 					originalDuration, flags.duration)
-				display.ShowWarning(message)
+				display.ShowWarning("%s", message)
 				if !display.ConfirmAction("Do you want to continue?").Confirmed {
 					display.ShowInfo("Operation cancelled")
 					return result.NewError(fmt.Errorf("operation cancelled")).Error
@@ -81,6 +85,34 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 			}
 		}
 
+		// A new effort replaces the old one, whichever form it was given in
+		if cmd.Flags().Changed("intensity") || cmd.Flags().Changed("rpe") {
+			intensity, rpe, err := parseEffort(cmd)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.Intensity = intensity
+			record.RPE = rpe
+		}
+
+		if cmd.Flags().Changed("date") {
+			// Sessions still to come can be moved to another future date
+			parseDate := validator.ParseDate
+			if record.IsPending(time.Now()) {
+				parseDate = validator.ParseDateAllowingFuture
+			}
+			date, err := parseDate(flags.date)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			if flags.date == "" {
+				date = models.CalendarDate(date)
+			}
+			record.Date = date
+		}
+		// Sessions logged with a time of day are stored by day from now on
+		record.Date = models.DateOnly(record.Date)
+
 		if cmd.Flags().Changed("notes") {
 			record.Notes = flags.notes
 		}
@@ -96,6 +128,44 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 			record.Completed = false
 		}
 
+		if cmd.Flags().Changed("distance") || cmd.Flags().Changed("elevation") {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			activity := settings.GetActivityCatalog().Find(string(record.Activity))
+			if activity == nil {
+				return result.NotFound("Activity", string(record.Activity)).Error
+			}
+			distance, elevation, err := parseDistance(cmd, *activity)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			if cmd.Flags().Changed("distance") {
+				record.Distance = distance
+			}
+			if cmd.Flags().Changed("elevation") {
+				record.ElevationGain = elevation
+			}
+		}
+
+		// Gear for another activity follows the session to the new activity's default
+		if cmd.Flags().Changed("gear") || record.Activity != originalActivity {
+			gearID, err := updatedGear(cmd, store, *record)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.GearID = gearID
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if !confirmPace(*record) {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
 		// Display summary of changes
 		if err := showUpdateSummary(originalActivity, record.Activity, originalDuration, record.Duration); err != nil {
 			return result.ValidationFailed(err).Error
@@ -106,12 +176,20 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 		}
 
 		// Perform update
-		if err := store.UpdateExercise(date, *record); err != nil {
+		if err := store.UpdateExercise(recordID, *record); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		gear, warning, err := gearMessages(store, record.GearID)
+		if err != nil {
 			return result.StorageError(err).Error
 		}
 
-		cmdResult := result.NewSuccess(*record, "Exercise record updated successfully")
+		cmdResult := result.NewSuccess(*record, append([]string{"Exercise record updated successfully"}, gear...)...)
 		display.ShowCommandResult(cmdResult)
+		if warning != "" {
+			display.ShowWarning("%s", warning)
+		}
 
 		return nil
 	}
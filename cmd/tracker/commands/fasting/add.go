@@ -0,0 +1,81 @@
+// cmd/tracker/commands/fasting/add.go
+package fasting
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Parse and validate date
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		// Without --date the record is for today, not the current moment
+		if flags.date == "" {
+			date = models.CalendarDate(date)
+		}
+
+		// Validate meal pattern
+		pattern, err := models.ParseMealPattern(flags.pattern)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := validator.ValidateNotes(flags.notes); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Look up the schedule in force on this date
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Create record with the expected pattern taken from the schedule
+		record := models.FastingRecord{
+			Date:            date,
+			ExpectedPattern: settings.FastingSchedules.ExpectedPattern(date),
+			ActualPattern:   pattern,
+			Source:          models.SourceManual,
+			Notes:           flags.notes,
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// A day has one fasting record, so an existing one is overwritten on request
+		existingRecord, err := store.GetFasting(date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if existingRecord != nil {
+			display.ShowWarning("Record already exists for %s", date.Format(validator.DateFormat))
+			confirmResult := display.ConfirmAction("Do you want to overwrite this record?")
+			if !confirmResult.Confirmed {
+				display.ShowInfo("Operation cancelled")
+				return result.NewError(fmt.Errorf("operation cancelled")).Error
+			}
+			if err := store.UpdateFasting(existingRecord.Date, record); err != nil {
+				return result.StorageError(err).Error
+			}
+		} else if err := store.AddFasting(record); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Use CommandResult for success
+		cmdResult := result.NewSuccess(record, "Fasting record added successfully")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
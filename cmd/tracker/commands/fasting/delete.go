@@ -0,0 +1,66 @@
+// cmd/tracker/commands/fasting/delete.go
+package fasting
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a fasting record",
+		RunE:  createDeleteCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of fasting record to delete (required)")
+	cmd.MarkFlagRequired("date")
+
+	return cmd
+}
+
+func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Parse and validate date
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Get record to show confirmation
+		record, err := store.GetFasting(date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if record == nil {
+			return result.NotFound("Fasting record", flags.date).Error
+		}
+
+		// Show confirmation with record details
+		confirmResult := display.ShowFastingDeleteConfirmation(
+			record.Date.Format(validator.DateFormat),
+			string(record.ExpectedPattern),
+			string(record.ActualPattern),
+			record.Notes,
+		)
+
+		if !confirmResult.Confirmed {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		if err := store.DeleteFasting(record.Date); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(nil, "Fasting record deleted successfully")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
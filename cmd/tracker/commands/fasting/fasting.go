@@ -2,13 +2,105 @@
 package fasting
 
 import (
+	"time"
+
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/spf13/cobra"
 )
 
+// Shared flags across fasting commands
+type fastingFlags struct {
+	// Basic flags for add/update
+	pattern string
+	date    string
+	notes   string
+
+	// List command flags
+	fromDate  string
+	toDate    string
+	lastWeek  bool
+	lastMonth bool
+
+	// Timer command flags
+	at string
+
+	// Schedule command flags
+	preset        string
+	effectiveFrom string
+	dayPatterns   map[time.Weekday]*string
+}
+
+var flags fastingFlags
+
+// NewFastingCmd creates the fasting command and all its subcommands
 func NewFastingCmd(store storage.StorageManager) *cobra.Command {
-	return &cobra.Command{
+	fastingCmd := &cobra.Command{
 		Use:   "fasting",
 		Short: "Manage fasting records",
+		Long: `Manage fasting records with full CRUD operations.
+
+Meal patterns:
+  full-fast   No meals for the day
+  one-meal    A single meal for the day
+  regular     Normal eating
+
+Examples:
+  # Add a fasting record
+  tracker fasting add --pattern full-fast --date 2024-01-08 --notes "Water only"
+
+  # Get fasting record for a specific date
+  tracker fasting get --date 2024-01-08
+
+  # List fasting records with compliance
+  tracker fasting list --from 2024-01-01 --to 2024-01-08
+
+  # Update a fasting record
+  tracker fasting update --date 2024-01-08 --pattern one-meal
+
+  # Delete a fasting record
+  tracker fasting delete --date 2024-01-08
+
+  # Time a fast and check on it
+  tracker fasting start --at "2024-01-08 20:00"
+  tracker fasting status
+  tracker fasting stop
+
+  # Switch to a 5:2 schedule from a given date
+  tracker fasting schedule set --preset 5:2 --from 2024-02-01`,
 	}
+
+	// Add subcommands
+	fastingCmd.AddCommand(
+		newAddCmd(store),
+		newGetCmd(store),
+		newListCmd(store),
+		newUpdateCmd(store),
+		newDeleteCmd(store),
+		newScheduleCmd(store),
+		newStartCmd(store),
+		newStopCmd(store),
+		newStatusCmd(store),
+		newCancelCmd(store),
+		newWindowsCmd(store),
+	)
+
+	return fastingCmd
+}
+
+// Add command implementation
+func newAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a new fasting record",
+		RunE:  createAddCmdRunner(store),
+	}
+
+	// Add flags
+	cmd.Flags().StringVarP(&flags.pattern, "pattern", "p", "", "Actual meal pattern: full-fast, one-meal or regular (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of fasting record (default: today)")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the fasting day")
+
+	cmd.MarkFlagRequired("pattern")
+
+	return cmd
 }
@@ -0,0 +1,139 @@
+// cmd/tracker/commands/fasting/list.go
+package fasting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+type fastingStats struct {
+	TotalRecords     int
+	CompliantRecords int
+	FullFastDays     int
+	OneMealDays      int
+	RegularDays      int
+}
+
+func newListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List fasting records",
+		RunE:  createListCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date for listing fasting records")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing fasting records")
+	cmd.Flags().BoolVarP(&flags.lastWeek, "week", "w", false, "Show last 7 days")
+	cmd.Flags().BoolVarP(&flags.lastMonth, "month", "m", false, "Show last month")
+
+	return cmd
+}
+
+func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var fromDate, toDate time.Time
+		var err error
+		var isDefaultRange bool
+
+		// Handle date range selection
+		switch {
+		case flags.lastWeek:
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-14")
+				fromDate = toDate.AddDate(0, 0, -7)
+			} else {
+				toDate = time.Now()
+				fromDate = toDate.AddDate(0, 0, -7)
+			}
+			isDefaultRange = false
+		case flags.lastMonth:
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-31")
+				fromDate, _ = time.Parse(validator.DateFormat, "2024-01-01")
+			} else {
+				toDate = time.Now()
+				fromDate = toDate.AddDate(0, -1, 0)
+			}
+			isDefaultRange = false
+		case flags.fromDate == "" && flags.toDate == "":
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-31")
+				fromDate = toDate.AddDate(0, 0, -30)
+			} else {
+				fromDate, toDate = validator.GetDefaultDateRange()
+			}
+			isDefaultRange = true
+		default:
+			fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			isDefaultRange = false
+		}
+
+		// Get records
+		records, err := store.GetFastingRange(fromDate, toDate, isDefaultRange)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(records) == 0 {
+			return result.NewError(fmt.Errorf("No fasting records found between %s and %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat))).Error
+		}
+
+		// Calculate statistics
+		stats := calculateFastingStats(records)
+
+		// Display results
+		display.ShowHeader(fmt.Sprintf("Fasting Records from %s to %s",
+			fromDate.Format(validator.DateFormat),
+			toDate.Format(validator.DateFormat)))
+
+		display.ShowFastingList(records)
+
+		display.ShowStats(map[string]string{
+			"Total Records":   fmt.Sprintf("%d", stats.TotalRecords),
+			"Compliant Days":  fmt.Sprintf("%d", stats.CompliantRecords),
+			"Compliance Rate": fmt.Sprintf("%.1f%%", float64(stats.CompliantRecords)/float64(stats.TotalRecords)*100),
+			"Full-Fast Days":  fmt.Sprintf("%d", stats.FullFastDays),
+			"One-Meal Days":   fmt.Sprintf("%d", stats.OneMealDays),
+			"Regular Days":    fmt.Sprintf("%d", stats.RegularDays),
+		})
+
+		return nil
+	}
+}
+
+func calculateFastingStats(records []models.FastingRecord) fastingStats {
+	stats := fastingStats{
+		TotalRecords: len(records),
+	}
+
+	for _, record := range records {
+		if record.IsCompliant() {
+			stats.CompliantRecords++
+		}
+		switch record.ActualPattern {
+		case models.FullFast:
+			stats.FullFastDays++
+		case models.OneMeal:
+			stats.OneMealDays++
+		case models.Regular:
+			stats.RegularDays++
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,201 @@
+// cmd/tracker/commands/fasting/schedule.go
+package fasting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newScheduleCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Show or change the weekly fasting schedule",
+		Long: fmt.Sprintf(`Show or change the weekly fasting schedule.
+
+Each schedule takes effect from a date, so records logged before a change keep
+the expectations that applied at the time.
+
+Presets: %s
+
+Examples:
+  # Show the schedule in force today and the schedule history
+  tracker fasting schedule show
+
+  # Switch to OMAD from a given date
+  tracker fasting schedule set --preset omad --from 2024-02-01
+
+  # Change individual days of the current schedule
+  tracker fasting schedule set --monday full-fast --friday one-meal`,
+			strings.Join(models.SchedulePresetNames(), ", ")),
+	}
+
+	cmd.AddCommand(
+		newScheduleShowCmd(store),
+		newScheduleSetCmd(store),
+	)
+
+	return cmd
+}
+
+func newScheduleShowCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the current fasting schedule",
+		RunE:  createScheduleShowCmdRunner(store),
+	}
+}
+
+func newScheduleSetCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the fasting schedule from a given date",
+		RunE:  createScheduleSetCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.preset, "preset", "p", "", "Start from a preset schedule")
+	cmd.Flags().StringVarP(&flags.effectiveFrom, "from", "f", "", "Date the schedule takes effect (default: today)")
+
+	flags.dayPatterns = make(map[time.Weekday]*string)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		flags.dayPatterns[day] = cmd.Flags().String(name, "", fmt.Sprintf("Meal pattern expected on %s", day))
+	}
+
+	return cmd
+}
+
+func createScheduleShowCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowFastingSchedule(settings.FastingSchedules.ScheduleFor(time.Now()))
+
+		if len(settings.FastingSchedules) > 0 {
+			display.ShowHeader("Schedule History:")
+			for _, schedule := range settings.FastingSchedules {
+				name := schedule.Name
+				if name == "" {
+					name = "custom"
+				}
+				fmt.Printf("  %s  %s\n", schedule.EffectiveFrom.Format(validator.DateFormat), name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func createScheduleSetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		effectiveFrom, err := validator.ParseDate(flags.effectiveFrom)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		effectiveFrom = time.Date(effectiveFrom.Year(), effectiveFrom.Month(), effectiveFrom.Day(), 0, 0, 0, 0, time.UTC)
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Start from a preset or from the schedule currently in force
+		var schedule models.FastingSchedule
+		if flags.preset != "" {
+			schedule, err = models.NewSchedulePreset(flags.preset, effectiveFrom)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		} else {
+			current := settings.FastingSchedules.ScheduleFor(effectiveFrom)
+			schedule = models.FastingSchedule{EffectiveFrom: effectiveFrom}
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				schedule.SetPattern(day, current.Pattern(day))
+			}
+		}
+
+		// Apply individual day overrides
+		changedDays := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if !cmd.Flags().Changed(strings.ToLower(day.String())) {
+				continue
+			}
+			pattern, err := models.ParseMealPattern(*flags.dayPatterns[day])
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			schedule.SetPattern(day, pattern)
+			changedDays = true
+		}
+
+		if flags.preset == "" && !changedDays {
+			return result.ValidationFailed(fmt.Errorf("specify a --preset or at least one weekday pattern")).Error
+		}
+		if changedDays {
+			schedule.Name = ""
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		display.ShowFastingSchedule(schedule)
+		if !display.ConfirmAction(fmt.Sprintf("Apply this schedule from %s?",
+			effectiveFrom.Format(validator.DateFormat))).Confirmed {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		settings.FastingSchedules = settings.FastingSchedules.Add(schedule)
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Re-evaluate records governed by the new schedule
+		updated, err := refreshExpectedPatterns(store, settings.FastingSchedules, effectiveFrom)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(nil, "Fasting schedule updated successfully")
+		if updated > 0 {
+			cmdResult = cmdResult.WithMessages(fmt.Sprintf("Updated expected pattern on %d existing record(s)", updated))
+		}
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
+
+// refreshExpectedPatterns recomputes the expected pattern of records on or after from
+func refreshExpectedPatterns(store storage.StorageManager, schedules models.FastingSchedules, from time.Time) (int, error) {
+	records, err := store.GetFastingRange(from, time.Now(), false)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, record := range records {
+		expected := schedules.ExpectedPattern(record.Date)
+		if record.ExpectedPattern == expected {
+			continue
+		}
+		record.ExpectedPattern = expected
+		if err := store.UpdateFasting(record.Date, record); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+
+	return updated, nil
+}
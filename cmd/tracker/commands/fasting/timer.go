@@ -0,0 +1,230 @@
+// cmd/tracker/commands/fasting/timer.go
+package fasting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newStartCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start timing a fast",
+		RunE:  createStartCmdRunner(store),
+	}
+
+	cmd.Flags().StringVar(&flags.at, "at", "", `When the fast started, "HH:MM" or "YYYY-MM-DD HH:MM" (default: now)`)
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the fast")
+
+	return cmd
+}
+
+func newStopCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the fast in progress",
+		RunE:  createStopCmdRunner(store),
+	}
+
+	cmd.Flags().StringVar(&flags.at, "at", "", `When the fast ended, "HH:MM" or "YYYY-MM-DD HH:MM" (default: now)`)
+
+	return cmd
+}
+
+func newStatusCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the fast in progress",
+		RunE:  createStatusCmdRunner(store),
+	}
+}
+
+func newCancelCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel",
+		Short: "Discard the fast in progress",
+		RunE:  createCancelCmdRunner(store),
+	}
+}
+
+func createStartCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		start, err := validator.ParseDateTime(flags.at)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := validator.ValidateNotes(flags.notes); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Only one fast can run at a time
+		active, err := store.GetActiveFastingWindow()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if active != nil {
+			return result.NewError(fmt.Errorf("a fast is already in progress since %s",
+				active.Start.Format(validator.DateTimeFormat))).Error
+		}
+
+		// A new fast can't start inside one that has already been logged
+		overlapping, err := store.GetFastingWindowRange(start, start)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if len(overlapping) > 0 {
+			return result.ValidationFailed(fmt.Errorf("start time falls within a logged fast (%s)",
+				overlapping[0].Start.Format(validator.DateTimeFormat))).Error
+		}
+
+		window := models.FastingWindow{
+			Start: start,
+			Notes: flags.notes,
+		}
+
+		if err := store.AddFastingWindow(window); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(window, "Fast started")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
+
+func createStopCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		end, err := validator.ParseDateTime(flags.at)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		window, err := store.GetActiveFastingWindow()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if window == nil {
+			return result.NewError(fmt.Errorf("no fast in progress")).Error
+		}
+
+		if err := window.Stop(end); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := store.UpdateFastingWindow(window.Start, *window); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Derive the daily pattern for every day the fast touched
+		updated, err := applyWindowPatterns(store, *window)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(*window, fmt.Sprintf("Fast completed after %.1f hours", window.Duration))
+		if updated > 0 {
+			cmdResult = cmdResult.WithMessages(fmt.Sprintf("Derived meal pattern for %d day(s)", updated))
+		}
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
+
+func createStatusCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		window, err := store.GetActiveFastingWindow()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if window == nil {
+			display.ShowInfo("No fast in progress")
+			return nil
+		}
+
+		display.ShowFastingWindow(*window, time.Now())
+
+		return nil
+	}
+}
+
+func createCancelCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		window, err := store.GetActiveFastingWindow()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if window == nil {
+			return result.NewError(fmt.Errorf("no fast in progress")).Error
+		}
+
+		display.ShowFastingWindow(*window, time.Now())
+		if !display.ConfirmAction("Are you sure you want to discard this fast?").Confirmed {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		if err := store.DeleteFastingWindow(window.Start); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil, "Fast discarded"))
+
+		return nil
+	}
+}
+
+// applyWindowPatterns derives the actual pattern for each day a fast touched.
+// Days whose pattern was declared by hand or derived from meals are left alone.
+func applyWindowPatterns(store storage.StorageManager, window models.FastingWindow) (int, error) {
+	settings, err := store.GetSettings()
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, day := range window.Days(time.Now()) {
+		dayStart, dayEnd := models.LocalDayBounds(day)
+		windows, err := store.GetFastingWindowRange(dayStart, dayEnd)
+		if err != nil {
+			return updated, err
+		}
+		pattern := models.DeriveMealPattern(windows, day)
+
+		record, err := store.GetFasting(day)
+		if err != nil {
+			return updated, err
+		}
+
+		switch {
+		case record == nil:
+			err = store.AddFasting(models.FastingRecord{
+				Date:            day,
+				ExpectedPattern: settings.FastingSchedules.ExpectedPattern(day),
+				ActualPattern:   pattern,
+				Source:          models.SourceTimer,
+			})
+		case record.CanDeriveFrom(models.SourceTimer):
+			record.ActualPattern = pattern
+			record.Source = models.SourceTimer
+			err = store.UpdateFasting(day, *record)
+		default:
+			continue
+		}
+		if err != nil {
+			return updated, err
+		}
+		updated++
+	}
+
+	return updated, nil
+}
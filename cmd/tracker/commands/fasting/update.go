@@ -0,0 +1,88 @@
+// cmd/tracker/commands/fasting/update.go
+package fasting
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newUpdateCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a fasting record",
+		RunE:  createUpdateCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the fasting record to update (required)")
+	cmd.Flags().StringVarP(&flags.pattern, "pattern", "p", "", "Updated meal pattern: full-fast, one-meal or regular")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Updated notes")
+
+	cmd.MarkFlagRequired("date")
+
+	return cmd
+}
+
+func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Parse and validate date
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Get existing record
+		record, err := store.GetFasting(date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if record == nil {
+			return result.NotFound("Fasting record", flags.date).Error
+		}
+
+		// Store original pattern for comparison
+		originalPattern := record.ActualPattern
+
+		// Update fields if provided
+		if cmd.Flags().Changed("pattern") {
+			pattern, err := models.ParseMealPattern(flags.pattern)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.ActualPattern = pattern
+			record.Source = models.SourceManual
+		}
+
+		if cmd.Flags().Changed("notes") {
+			if err := validator.ValidateNotes(flags.notes); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.Notes = flags.notes
+		}
+
+		// Display summary of changes
+		if originalPattern != record.ActualPattern {
+			display.ShowHeader("\nUpdate Summary:")
+			fmt.Printf("Pattern: %s -> %s\n", originalPattern, record.ActualPattern)
+			if !display.ConfirmAction("Do you want to apply these changes?").Confirmed {
+				display.ShowInfo("Operation cancelled")
+				return result.NewError(fmt.Errorf("operation cancelled")).Error
+			}
+		}
+
+		// Perform update
+		if err := store.UpdateFasting(record.Date, *record); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(*record, "Fasting record updated successfully")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+// cmd/tracker/commands/fasting/windows.go
+package fasting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+type windowStats struct {
+	CompletedFasts int
+	TotalHours     float64
+	AverageHours   float64
+	LongestHours   float64
+	MultiDayFasts  int
+}
+
+func newWindowsCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "windows",
+		Short: "List timed fasts",
+		RunE:  createWindowsCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date for listing fasts")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing fasts")
+
+	return cmd
+}
+
+func createWindowsCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var fromDate, toDate time.Time
+		var err error
+
+		if flags.fromDate == "" && flags.toDate == "" {
+			fromDate, toDate = validator.GetDefaultDateRange()
+		} else {
+			fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+
+		// Include the whole of the final day
+		windows, err := store.GetFastingWindowRange(fromDate, toDate.AddDate(0, 0, 1))
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(windows) == 0 {
+			return result.NewError(fmt.Errorf("No fasts found between %s and %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat))).Error
+		}
+
+		stats := calculateWindowStats(windows)
+
+		display.ShowHeader(fmt.Sprintf("Fasts from %s to %s",
+			fromDate.Format(validator.DateFormat),
+			toDate.Format(validator.DateFormat)))
+
+		display.ShowFastingWindowList(windows, time.Now())
+
+		display.ShowStats(map[string]string{
+			"Completed Fasts":  fmt.Sprintf("%d", stats.CompletedFasts),
+			"Total Hours":      fmt.Sprintf("%.1f hours", stats.TotalHours),
+			"Average Duration": fmt.Sprintf("%.1f hours", stats.AverageHours),
+			"Longest Fast":     fmt.Sprintf("%.1f hours", stats.LongestHours),
+			"Multi-Day Fasts":  fmt.Sprintf("%d", stats.MultiDayFasts),
+		})
+
+		return nil
+	}
+}
+
+func calculateWindowStats(windows []models.FastingWindow) windowStats {
+	var stats windowStats
+
+	for _, window := range windows {
+		if window.InProgress() {
+			continue
+		}
+		stats.CompletedFasts++
+		stats.TotalHours += window.Duration
+		if window.Duration > stats.LongestHours {
+			stats.LongestHours = window.Duration
+		}
+		if window.Duration >= 36 {
+			stats.MultiDayFasts++
+		}
+	}
+
+	if stats.CompletedFasts > 0 {
+		stats.AverageHours = stats.TotalHours / float64(stats.CompletedFasts)
+	}
+
+	return stats
+}
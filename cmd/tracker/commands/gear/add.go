@@ -0,0 +1,86 @@
+// cmd/tracker/commands/gear/add.go
+package gear
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		activity := settings.GetActivityCatalog().Find(flags.activity)
+		if activity == nil {
+			return result.ValidationFailed(fmt.Errorf("invalid activity type: %s (see 'tracker activity list')", flags.activity)).Error
+		}
+
+		retireMiles, retireHours, err := parseAmounts("retire-at", flags.retireAt)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		initialMiles, initialHours, err := parseAmounts("initial", flags.initial)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		list, err := store.GetGear()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		existing, err := list.Find(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if existing != nil && !existing.Retired {
+			return result.ValidationFailed(fmt.Errorf("gear named %s already exists (%s)", existing.Name, existing.ID)).Error
+		}
+
+		gear := models.Gear{
+			Name:         strings.TrimSpace(args[0]),
+			Activity:     activity.Name,
+			InitialMiles: initialMiles,
+			InitialHours: initialHours,
+			RetireMiles:  retireMiles,
+			RetireHours:  retireHours,
+			Added:        models.CalendarDate(time.Now()),
+		}
+		if err := gear.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// The first gear for an activity becomes its default. The previous
+		// default is only cleared once the new gear is saved.
+		previous := list.DefaultFor(activity.Name)
+		gear.Default = flags.makeDefault || previous == nil
+
+		gear, err = store.AddGear(gear)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if gear.Default && previous != nil {
+			previous.Default = false
+			if err := store.UpdateGear(previous.ID, *previous); err != nil {
+				return result.StorageError(err).Error
+			}
+		}
+
+		messages := []string{"Gear added successfully"}
+		if gear.Default {
+			messages = append(messages, fmt.Sprintf("Default gear for %s sessions", gear.Activity))
+		}
+		display.ShowCommandResult(result.NewSuccess(models.GearUsage{Gear: gear}, messages...))
+
+		return nil
+	}
+}
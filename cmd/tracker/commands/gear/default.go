@@ -0,0 +1,66 @@
+// cmd/tracker/commands/gear/default.go
+package gear
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newDefaultCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "default GEAR",
+		Short: "Make gear the default for its activity",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createDefaultCmdRunner(store),
+	}
+}
+
+func createDefaultCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		list, err := store.GetGear()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		gear, err := list.Find(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		switch {
+		case gear == nil:
+			return result.NotFound("Gear", args[0]).Error
+		case gear.Retired:
+			return result.ValidationFailed(fmt.Errorf("%s is retired (restore it with 'tracker gear retire %s --restore')", gear.Name, gear.ID)).Error
+		case gear.Default:
+			return result.NewError(fmt.Errorf("%s is already the default for %s", gear.Name, gear.Activity)).Error
+		}
+
+		// Only one piece of gear is the default for each activity. The new
+		// default is saved first so a failure never leaves the activity without one.
+		previous := list.DefaultFor(gear.Activity)
+		gear.Default = true
+		if err := store.UpdateGear(gear.ID, *gear); err != nil {
+			return result.StorageError(err).Error
+		}
+		if previous != nil {
+			previous.Default = false
+			if err := store.UpdateGear(previous.ID, *previous); err != nil {
+				return result.StorageError(err).Error
+			}
+		}
+
+		usage, err := gearUsage(store, models.GearList{*gear})
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		display.ShowCommandResult(result.NewSuccess(usage[0],
+			fmt.Sprintf("Default gear for %s sessions", gear.Activity)))
+
+		return nil
+	}
+}
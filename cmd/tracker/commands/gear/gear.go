@@ -0,0 +1,115 @@
+// cmd/tracker/commands/gear/gear.go
+package gear
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// Shared flags across gear commands
+type gearFlags struct {
+	// Add flags
+	activity    string
+	retireAt    []string
+	initial     []string
+	makeDefault bool
+
+	// List flags
+	all bool
+
+	// Retire flags
+	restore bool
+}
+
+var flags gearFlags
+
+// NewGearCmd creates the gear command and all its subcommands
+func NewGearCmd(store storage.StorageManager) *cobra.Command {
+	gearCmd := &cobra.Command{
+		Use:   "gear",
+		Short: "Track shoes, bikes and other gear",
+		Long: `Track the mileage and hours on gear such as running shoes, bikes and skis.
+
+Exercise sessions are linked to gear with --gear, or to the activity's default
+gear when none is given. Usage accumulates from the linked sessions, and the
+tracker warns when gear passes its retirement threshold.
+
+Examples:
+  # Add running shoes to retire at 400 miles; the first gear for an activity is its default
+  tracker gear add "Pegasus 40" --activity jogging --retire-at 400mi
+
+  # Add a bike that already has some miles on it, retiring at 8000 km or 300 hours
+  tracker gear add "Road Bike" --activity cycling --initial 1200mi --retire-at 8000km --retire-at 300h
+
+  # Link a session to gear other than the default, or to none
+  tracker exercise add --activity jogging --duration 45 --distance 5 --gear "Trail Shoes"
+  tracker exercise add --activity jogging --duration 45 --gear none
+
+  # Show usage, make other gear the default, and retire worn out gear
+  tracker gear list
+  tracker gear default "Trail Shoes"
+  tracker gear retire "Pegasus 40"`,
+	}
+
+	gearCmd.AddCommand(
+		newAddCmd(store),
+		newListCmd(store),
+		newDefaultCmd(store),
+		newRetireCmd(store),
+	)
+
+	return gearCmd
+}
+
+// Add command implementation
+func newAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Add gear",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createAddCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", "", "Activity the gear is used for (required)")
+	cmd.Flags().StringArrayVar(&flags.retireAt, "retire-at", nil, "Retire after a distance or time, e.g. 400mi, 650km or 300h (repeatable)")
+	cmd.Flags().StringArrayVar(&flags.initial, "initial", nil, "Usage from before it was tracked, e.g. 120mi or 40h (repeatable)")
+	cmd.Flags().BoolVar(&flags.makeDefault, "default", false, "Make it the default gear for the activity")
+
+	cmd.MarkFlagRequired("activity")
+
+	return cmd
+}
+
+// gearUsage totals the usage of each piece of gear from its linked sessions
+func gearUsage(store storage.StorageManager, list models.GearList) ([]models.GearUsage, error) {
+	records, err := store.GetExerciseRange(time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), false)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make([]models.GearUsage, len(list))
+	for i, gear := range list {
+		usage[i] = models.ComputeGearUsage(gear, records)
+	}
+	return usage, nil
+}
+
+// parseAmounts reads up to one distance and one time into miles and hours
+func parseAmounts(name string, values []string) (miles, hours float64, err error) {
+	for _, value := range values {
+		m, h, err := models.ParseGearAmount(value)
+		if err != nil {
+			return 0, 0, err
+		}
+		if (m > 0 && miles > 0) || (h > 0 && hours > 0) {
+			return 0, 0, fmt.Errorf("--%s takes at most one distance and one time", name)
+		}
+		miles += m
+		hours += h
+	}
+	return miles, hours, nil
+}
@@ -0,0 +1,59 @@
+// cmd/tracker/commands/gear/list.go
+package gear
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List gear with its usage",
+		RunE:  createListCmdRunner(store),
+	}
+
+	cmd.Flags().BoolVar(&flags.all, "all", false, "Include retired gear")
+
+	return cmd
+}
+
+func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		list, err := store.GetGear()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		var shown models.GearList
+		for _, gear := range list {
+			if flags.all || !gear.Retired {
+				shown = append(shown, gear)
+			}
+		}
+		if len(shown) == 0 {
+			return result.NewError(fmt.Errorf("No gear found (add some with 'tracker gear add')")).Error
+		}
+
+		usage, err := gearUsage(store, shown)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowHeader("Gear")
+		display.ShowGearList(usage)
+
+		for _, u := range usage {
+			if warning := u.RetirementWarning(); warning != "" {
+				display.ShowWarning("%s", warning)
+			}
+		}
+
+		return nil
+	}
+}
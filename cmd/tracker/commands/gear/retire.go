@@ -0,0 +1,73 @@
+// cmd/tracker/commands/gear/retire.go
+package gear
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newRetireCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire GEAR",
+		Short: "Retire gear so it isn't linked to new sessions",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createRetireCmdRunner(store),
+	}
+
+	cmd.Flags().BoolVar(&flags.restore, "restore", false, "Put retired gear back in use")
+
+	return cmd
+}
+
+func createRetireCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		list, err := store.GetGear()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		gear, err := list.Find(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if gear == nil {
+			return result.NotFound("Gear", args[0]).Error
+		}
+
+		retire := !flags.restore
+		if gear.Retired == retire {
+			state := "in use"
+			if retire {
+				state = "retired"
+			}
+			return result.NewError(fmt.Errorf("%s is already %s", gear.Name, state)).Error
+		}
+
+		// Retired gear stops being the default; restored gear becomes the
+		// default again if its activity has none
+		gear.Default = !retire && list.DefaultFor(gear.Activity) == nil
+		gear.Retired = retire
+
+		if err := store.UpdateGear(gear.ID, *gear); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		usage, err := gearUsage(store, models.GearList{*gear})
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		message := "Gear retired; its sessions are kept"
+		if !retire {
+			message = "Gear restored"
+		}
+		display.ShowCommandResult(result.NewSuccess(usage[0], message))
+
+		return nil
+	}
+}
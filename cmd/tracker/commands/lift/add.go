@@ -0,0 +1,128 @@
+// cmd/tracker/commands/lift/add.go
+package lift
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		// Without --date the session is for today, not the current moment
+		if flags.date == "" {
+			date = models.CalendarDate(date)
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		activity, added, err := resolveStrengthActivity(&settings)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		var lifts []models.Lift
+		for _, value := range flags.lifts {
+			lift, err := models.ParseLift(value)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			for _, existing := range lifts {
+				if existing.Name == lift.Name {
+					return result.ValidationFailed(fmt.Errorf("%s is listed more than once", lift.Name)).Error
+				}
+			}
+			lifts = append(lifts, lift)
+		}
+
+		// A logged lifting session has been done, so it counts toward the goals
+		record := models.ExerciseRecord{
+			Date:      date,
+			Activity:  activity.Name,
+			Duration:  flags.duration,
+			RPE:       flags.rpe,
+			Notes:     flags.notes,
+			Completed: true,
+			Lifts:     lifts,
+		}
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if added {
+			if err := store.SaveSettings(settings); err != nil {
+				return result.StorageError(err).Error
+			}
+		}
+
+		record, err = store.AddExercise(record)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Report progress toward the daily goal across all of the day's sessions
+		sameDay, err := store.GetExerciseRange(date, date, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		day := models.GroupExerciseByDay(sameDay)[0]
+		goal := settings.ExerciseGoals.GoalFor(date)
+
+		messages := []string{
+			"Lift session added successfully",
+			fmt.Sprintf("%s: %d minutes across %d session(s), %s",
+				day.Date.Format(validator.DateFormat), day.TotalMinutes, day.Sessions, goal.DailyStatus(day)),
+			fmt.Sprintf("Total volume: %.0f lbs", record.LiftVolume()),
+		}
+		if added {
+			messages = append(messages, fmt.Sprintf("Added %s to the activity catalog", activity.Name))
+		}
+		display.ShowCommandResult(result.NewSuccess(record, messages...))
+
+		return nil
+	}
+}
+
+// resolveStrengthActivity looks up --activity in the catalog, which must be a
+// strength activity. The default weight lifting activity is added to catalogs
+// saved before it existed; added reports whether settings need saving.
+func resolveStrengthActivity(settings *models.Settings) (models.Activity, bool, error) {
+	catalog := settings.GetActivityCatalog()
+	activity := catalog.Find(flags.activity)
+
+	added := false
+	if activity == nil && models.NormalizeActivityName(flags.activity) == models.WeightLifting {
+		for _, builtin := range models.DefaultActivityCatalog() {
+			if builtin.Name == models.WeightLifting {
+				if err := catalog.Add(builtin); err != nil {
+					return models.Activity{}, false, err
+				}
+			}
+		}
+		settings.Activities = catalog
+		activity = catalog.Find(flags.activity)
+		added = true
+	}
+
+	switch {
+	case activity == nil:
+		return models.Activity{}, false, fmt.Errorf("invalid activity type: %s (see 'tracker activity list' or add it with 'tracker activity add')", flags.activity)
+	case activity.Archived:
+		return models.Activity{}, false, fmt.Errorf("activity %s is archived (restore it with 'tracker activity archive %s --restore')", activity.Name, activity.Name)
+	case activity.Category != models.CategoryStrength:
+		return models.Activity{}, false, fmt.Errorf("%s is a %s activity, lift sessions need a strength activity", activity.Name, activity.Category)
+	}
+
+	return *activity, added, nil
+}
@@ -0,0 +1,115 @@
+// cmd/tracker/commands/lift/history.go
+package lift
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newHistoryCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history LIFT",
+		Short: "Show progression for a lift",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createHistoryCmdRunner(store),
+	}
+
+	cmd.Flags().StringVar(&flags.formula, "formula", string(models.Epley), "1RM formula: epley or brzycki")
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date (default: all history)")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date (default: today)")
+
+	return cmd
+}
+
+func createHistoryCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		formula, err := models.ParseOneRepMaxFormula(flags.formula)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		var fromDate time.Time
+		toDate := time.Now()
+		if flags.fromDate != "" || flags.toDate != "" {
+			fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+
+		records, err := store.GetExerciseRange(fromDate, toDate, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		sort.SliceStable(records, func(i, j int) bool { return records[i].Date.Before(records[j].Date) })
+
+		name := models.NormalizeLiftName(args[0])
+		var rows [][]string
+		var best, first, previous, totalVolume float64
+		var bestDate time.Time
+		for _, record := range records {
+			lift := record.FindLift(name)
+			if lift == nil {
+				continue
+			}
+
+			oneRepMax := lift.OneRepMax(formula)
+			change := "-"
+			if len(rows) > 0 && oneRepMax > 0 && previous > 0 {
+				change = fmt.Sprintf("%+.1f", oneRepMax-previous)
+			}
+			if len(rows) == 0 {
+				first = oneRepMax
+			}
+			if oneRepMax > best {
+				best, bestDate = oneRepMax, record.Date
+			}
+			previous = oneRepMax
+			totalVolume += lift.Volume()
+
+			top := lift.TopSet(formula)
+			topSet := fmt.Sprintf("%d reps", top.Reps)
+			estimate := "-"
+			if top.Weight > 0 {
+				topSet = fmt.Sprintf("%d x %.1f lbs", top.Reps, top.Weight)
+				estimate = fmt.Sprintf("%.1f", oneRepMax)
+			}
+
+			rows = append(rows, []string{
+				record.Date.Format(validator.DateFormat),
+				lift.FormatSets(),
+				topSet,
+				fmt.Sprintf("%.0f", lift.Volume()),
+				estimate,
+				change,
+			})
+		}
+
+		if len(rows) == 0 {
+			return result.NotFound("Lift history", name).Error
+		}
+
+		display.ShowHeader(fmt.Sprintf("%s History (%s 1RM)", name, formula))
+		display.ShowTable([]string{"Date", "Sets", "Top Set", "Volume (lbs)", "Est. 1RM", "Change"}, rows)
+
+		stats := map[string]string{
+			"Sessions":     fmt.Sprintf("%d", len(rows)),
+			"Total Volume": fmt.Sprintf("%.0f lbs", totalVolume),
+		}
+		if best > 0 {
+			stats["Best Est. 1RM"] = fmt.Sprintf("%.1f lbs on %s", best, bestDate.Format(validator.DateFormat))
+			stats["Progress"] = fmt.Sprintf("%+.1f lbs", previous-first)
+		}
+		display.ShowStats(stats)
+
+		return nil
+	}
+}
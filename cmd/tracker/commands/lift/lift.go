@@ -0,0 +1,77 @@
+// cmd/tracker/commands/lift/lift.go
+package lift
+
+import (
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// Shared flags across lift commands
+type liftFlags struct {
+	// Add flags
+	activity string
+	date     string
+	duration int
+	lifts    []string
+	rpe      int
+	notes    string
+
+	// History flags
+	formula  string
+	fromDate string
+	toDate   string
+}
+
+var flags liftFlags
+
+// NewLiftCmd creates the lift command and all its subcommands
+func NewLiftCmd(store storage.StorageManager) *cobra.Command {
+	liftCmd := &cobra.Command{
+		Use:   "lift",
+		Short: "Log strength training sessions",
+		Long: `Log strength training sessions made up of lifts, each with sets of reps at a weight.
+
+Sessions are stored as exercise records in a strength activity, so their
+minutes count toward the exercise goals alongside cardio.
+
+Sets are written as [COUNTx]REPS[@WEIGHT] in pounds, separated by commas.
+Leave out the weight for bodyweight exercises.
+
+Examples:
+  # Log a session with three lifts
+  tracker lift add --duration 50 --lift squat=3x5@225 --lift "bench press=5@185,5@185,4@185" --lift pullup=3x8
+
+  # Show progression and estimated 1RM for a lift
+  tracker lift history squat
+  tracker lift history "bench press" --formula brzycki`,
+	}
+
+	liftCmd.AddCommand(
+		newAddCmd(store),
+		newHistoryCmd(store),
+	)
+
+	return liftCmd
+}
+
+// Add command implementation
+func newAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a strength training session",
+		RunE:  createAddCmdRunner(store),
+	}
+
+	cmd.Flags().StringArrayVarP(&flags.lifts, "lift", "l", nil, "Lift as NAME=SETS, e.g. squat=3x5@225 (repeatable, required)")
+	cmd.Flags().IntVarP(&flags.duration, "duration", "d", 0, "Session length in minutes (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "t", "", "Date of the session (default: today)")
+	cmd.Flags().StringVarP(&flags.activity, "activity", "a", string(models.WeightLifting), "Strength activity from the catalog")
+	cmd.Flags().IntVarP(&flags.rpe, "rpe", "r", 0, "Rate of perceived exertion from 1 to 10")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the session")
+
+	cmd.MarkFlagRequired("lift")
+	cmd.MarkFlagRequired("duration")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+// cmd/tracker/commands/meal/delete.go
+package meal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a logged meal",
+		RunE:  createDeleteCmdRunner(store),
+	}
+
+	cmd.Flags().StringVar(&flags.at, "at", "", "Time of the meal to delete as HH:MM (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the meal (default: today)")
+	cmd.MarkFlagRequired("at")
+
+	return cmd
+}
+
+func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		at, err := parseMealTime(flags.date, flags.at)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Find the meal logged in that minute
+		meals, err := store.GetMealRange(at, at.Add(time.Minute))
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if len(meals) == 0 {
+			return result.NotFound("Meal", at.Format(validator.DateTimeFormat)).Error
+		}
+		meal := meals[0]
+
+		display.ShowMealEntry(meal)
+		if !display.ConfirmAction("Are you sure you want to delete this meal?").Confirmed {
+			display.ShowInfo("Operation cancelled")
+			return result.NewError(fmt.Errorf("operation cancelled")).Error
+		}
+
+		if err := store.DeleteMeal(meal.Time); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		day := models.CalendarDate(meal.Time)
+		dayStart, dayEnd := models.LocalDayBounds(day)
+		remaining, err := store.GetMealRange(dayStart, dayEnd)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		messages := []string{"Meal deleted successfully"}
+		if len(remaining) == 0 {
+			// Without meals there is nothing to derive the day's pattern from
+			removed, err := clearMealPattern(store, day)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			if removed {
+				messages = append(messages, fmt.Sprintf("Pattern for %s removed: no meals left to derive it from",
+					day.Format(validator.DateFormat)))
+			}
+		} else {
+			// Re-derive the day's fasting record from the meals left
+			record, derived, err := applyMealPattern(store, day)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			messages = append(messages, derivationMessage(record, derived))
+		}
+
+		cmdResult := result.NewSuccess(nil, messages...)
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
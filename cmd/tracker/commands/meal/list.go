@@ -0,0 +1,107 @@
+// cmd/tracker/commands/meal/list.go
+package meal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List logged meals by day",
+		RunE:  createListCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date for listing meals")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing meals")
+
+	return cmd
+}
+
+func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var fromDate, toDate time.Time
+		var err error
+
+		if flags.fromDate == "" && flags.toDate == "" {
+			fromDate, toDate = validator.GetDefaultDateRange()
+		} else {
+			fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+		}
+
+		rangeStart, _ := models.LocalDayBounds(fromDate)
+		_, rangeEnd := models.LocalDayBounds(toDate)
+		meals, err := store.GetMealRange(rangeStart, rangeEnd)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(meals) == 0 {
+			return result.NewError(fmt.Errorf("No meals found between %s and %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat))).Error
+		}
+
+		// Group meals by calendar day, keeping days in order
+		var days []time.Time
+		byDay := make(map[time.Time][]models.MealEntry)
+		for _, meal := range meals {
+			day := models.CalendarDate(meal.Time)
+			if _, ok := byDay[day]; !ok {
+				days = append(days, day)
+			}
+			byDay[day] = append(byDay[day], meal)
+		}
+
+		display.ShowHeader(fmt.Sprintf("Meals from %s to %s",
+			fromDate.Format(validator.DateFormat),
+			toDate.Format(validator.DateFormat)))
+
+		var rows [][]string
+		var totalWindow float64
+		for _, day := range days {
+			dayMeals := byDay[day]
+			pattern, window := models.DeriveFromMeals(dayMeals)
+			totalWindow += window.Hours()
+
+			// Show the recorded pattern when it has been overridden
+			if record, err := store.GetFasting(day); err == nil && record != nil && !record.IsDerived() {
+				pattern = record.ActualPattern + " (override)"
+			}
+
+			times := make([]string, len(dayMeals))
+			for i, meal := range dayMeals {
+				times[i] = meal.Time.Format(validator.TimeFormat)
+			}
+
+			rows = append(rows, []string{
+				day.Format(validator.DateFormat),
+				strings.Join(times, ", "),
+				string(pattern),
+				fmt.Sprintf("%.1f h", window.Hours()),
+			})
+		}
+		display.ShowTable([]string{"Date", "Meals", "Pattern", "Eating Window"}, rows)
+
+		display.ShowStats(map[string]string{
+			"Days Logged":           fmt.Sprintf("%d", len(days)),
+			"Total Meals":           fmt.Sprintf("%d", len(meals)),
+			"Average Meals Per Day": fmt.Sprintf("%.1f", float64(len(meals))/float64(len(days))),
+			"Average Eating Window": fmt.Sprintf("%.1f hours", totalWindow/float64(len(days))),
+		})
+
+		return nil
+	}
+}
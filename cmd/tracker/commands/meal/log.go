@@ -0,0 +1,126 @@
+// cmd/tracker/commands/meal/log.go
+package meal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func createLogCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		at, err := parseMealTime(flags.date, flags.at)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := validator.ValidateNotes(flags.notes); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		meal := models.MealEntry{
+			Time:  at,
+			Notes: flags.notes,
+		}
+
+		if err := store.AddMeal(meal); err != nil {
+			if err.Error() == "duplicate_date" {
+				return result.NewError(fmt.Errorf("a meal is already logged at %s",
+					at.Format(validator.DateTimeFormat))).Error
+			}
+			return result.StorageError(err).Error
+		}
+
+		// Re-derive the day's fasting record
+		record, derived, err := applyMealPattern(store, models.CalendarDate(at))
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(meal, "Meal logged successfully")
+		cmdResult = cmdResult.WithMessages(derivationMessage(record, derived))
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
+
+// parseMealTime combines the optional --date with the --at clock time
+func parseMealTime(date, at string) (time.Time, error) {
+	if date == "" {
+		return validator.ParseDateTime(at)
+	}
+	if _, err := validator.ParseDate(date); err != nil {
+		return time.Time{}, err
+	}
+	if at == "" {
+		return time.Time{}, fmt.Errorf("--at is required when --date is given")
+	}
+	return validator.ParseDateTime(date + " " + at)
+}
+
+// applyMealPattern derives a day's pattern and eating window from its meals,
+// unless the day's pattern has been overridden
+func applyMealPattern(store storage.StorageManager, day time.Time) (models.FastingRecord, bool, error) {
+	dayStart, dayEnd := models.LocalDayBounds(day)
+	meals, err := store.GetMealRange(dayStart, dayEnd)
+	if err != nil {
+		return models.FastingRecord{}, false, err
+	}
+	pattern, window := models.DeriveFromMeals(meals)
+
+	existing, err := store.GetFasting(day)
+	if err != nil {
+		return models.FastingRecord{}, false, err
+	}
+
+	if existing != nil && !existing.CanDeriveFrom(models.SourceMeals) {
+		return *existing, false, nil
+	}
+
+	if existing == nil {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return models.FastingRecord{}, false, err
+		}
+		record := models.FastingRecord{
+			Date:            day,
+			ExpectedPattern: settings.FastingSchedules.ExpectedPattern(day),
+			ActualPattern:   pattern,
+			Source:          models.SourceMeals,
+			EatingWindow:    window.Hours(),
+		}
+		return record, true, store.AddFasting(record)
+	}
+
+	existing.ActualPattern = pattern
+	existing.Source = models.SourceMeals
+	existing.EatingWindow = window.Hours()
+	return *existing, true, store.UpdateFasting(day, *existing)
+}
+
+// clearMealPattern removes a day's fasting record once its last meal is
+// deleted, leaving the day with no derived pattern. Records the user declared
+// or derived from something else are kept.
+func clearMealPattern(store storage.StorageManager, day time.Time) (bool, error) {
+	existing, err := store.GetFasting(day)
+	if err != nil || existing == nil || existing.Source != models.SourceMeals {
+		return false, err
+	}
+	return true, store.DeleteFasting(existing.Date)
+}
+
+func derivationMessage(record models.FastingRecord, derived bool) string {
+	if !derived {
+		return fmt.Sprintf("Pattern for %s is overridden as %s",
+			record.Date.Format(validator.DateFormat), record.ActualPattern)
+	}
+	return fmt.Sprintf("Pattern for %s derived as %s (eating window %.1f hours)",
+		record.Date.Format(validator.DateFormat), record.ActualPattern, record.EatingWindow)
+}
@@ -0,0 +1,78 @@
+// cmd/tracker/commands/meal/meal.go
+package meal
+
+import (
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// Shared flags across meal commands
+type mealFlags struct {
+	// Basic flags for log/delete
+	at    string
+	date  string
+	notes string
+
+	// Override command flags
+	pattern string
+	clear   bool
+
+	// List command flags
+	fromDate string
+	toDate   string
+}
+
+var flags mealFlags
+
+// NewMealCmd creates the meal command and all its subcommands
+func NewMealCmd(store storage.StorageManager) *cobra.Command {
+	mealCmd := &cobra.Command{
+		Use:   "meal",
+		Short: "Log meals and derive the daily meal pattern",
+		Long: `Log individual meals. Each day's fasting record is derived from its meals:
+no meals is a full fast, one meal is one-meal and more is regular.
+
+Examples:
+  # Log a meal eaten today
+  tracker meal log --at 18:30 --notes "Salmon and greens"
+
+  # Log a meal on an earlier day
+  tracker meal log --date 2024-01-08 --at 12:15
+
+  # List meals with the derived daily pattern and eating window
+  tracker meal list --from 2024-01-01 --to 2024-01-08
+
+  # Override the derived pattern for a day, or go back to the derived one
+  tracker meal override --date 2024-01-08 --pattern one-meal
+  tracker meal override --date 2024-01-08 --clear
+
+  # Delete a logged meal
+  tracker meal delete --date 2024-01-08 --at 12:15`,
+	}
+
+	// Add subcommands
+	mealCmd.AddCommand(
+		newLogCmd(store),
+		newListCmd(store),
+		newOverrideCmd(store),
+		newDeleteCmd(store),
+	)
+
+	return mealCmd
+}
+
+// Log command implementation
+func newLogCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "Log a meal",
+		RunE:  createLogCmdRunner(store),
+	}
+
+	// Add flags
+	cmd.Flags().StringVar(&flags.at, "at", "", "Time of the meal as HH:MM (default: now)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the meal (default: today)")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the meal")
+
+	return cmd
+}
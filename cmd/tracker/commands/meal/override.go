@@ -0,0 +1,97 @@
+// cmd/tracker/commands/meal/override.go
+package meal
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newOverrideCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "override",
+		Short: "Override or restore the derived meal pattern for a day",
+		RunE:  createOverrideCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date to override (required)")
+	cmd.Flags().StringVarP(&flags.pattern, "pattern", "p", "", "Meal pattern to use instead of the derived one")
+	cmd.Flags().BoolVar(&flags.clear, "clear", false, "Remove the override and derive the pattern from meals")
+	cmd.MarkFlagRequired("date")
+
+	return cmd
+}
+
+func createOverrideCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if flags.clear == (flags.pattern != "") {
+			return result.ValidationFailed(fmt.Errorf("specify either --pattern or --clear")).Error
+		}
+
+		existing, err := store.GetFasting(date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if flags.clear {
+			if existing == nil || existing.IsDerived() {
+				return result.NewError(fmt.Errorf("no override set for %s", flags.date)).Error
+			}
+			// Mark as derived so the meal log can replace it
+			existing.Source = models.SourceMeals
+			if err := store.UpdateFasting(date, *existing); err != nil {
+				return result.StorageError(err).Error
+			}
+			record, derived, err := applyMealPattern(store, date)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			cmdResult := result.NewSuccess(record, "Override removed", derivationMessage(record, derived))
+			display.ShowCommandResult(cmdResult)
+			return nil
+		}
+
+		pattern, err := models.ParseMealPattern(flags.pattern)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if existing == nil {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			record := models.FastingRecord{
+				Date:            date,
+				ExpectedPattern: settings.FastingSchedules.ExpectedPattern(date),
+				ActualPattern:   pattern,
+				Source:          models.SourceManual,
+			}
+			if err := store.AddFasting(record); err != nil {
+				return result.StorageError(err).Error
+			}
+			display.ShowCommandResult(result.NewSuccess(record, "Override set"))
+			return nil
+		}
+
+		existing.ActualPattern = pattern
+		existing.Source = models.SourceManual
+		if err := store.UpdateFasting(date, *existing); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(*existing, "Override set"))
+
+		return nil
+	}
+}
@@ -4,8 +4,12 @@ package commands
 import (
 	"log"
 
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/activity"
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/exercise"
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/fasting"
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/gear"
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/lift"
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/meal"
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/soda"
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/weight"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
@@ -22,7 +26,10 @@ Command Structure (CRUD operations):
   CREATE:
     tracker weight add --value 185.5 --date 2024-01-08 --notes "Morning weight"
     tracker exercise add --activity jogging --duration 45 --date 2024-01-08
+    tracker activity add swimming --category cardio --alias swim
+    tracker gear add "Pegasus 40" --activity jogging --retire-at 400mi
     tracker fasting add --pattern full-fast --date 2024-01-08
+    tracker meal log --at 18:30 --notes "Dinner"
     tracker soda add --consumed --quantity 12 --date 2024-01-08
 
   READ:
@@ -51,7 +58,11 @@ func Execute(testMode bool) error {
 	// Add main command groups
 	rootCmd.AddCommand(weight.NewWeightCmd(store))
 	rootCmd.AddCommand(exercise.NewExerciseCmd(store))
+	rootCmd.AddCommand(activity.NewActivityCmd(store))
+	rootCmd.AddCommand(lift.NewLiftCmd(store))
+	rootCmd.AddCommand(gear.NewGearCmd(store))
 	rootCmd.AddCommand(fasting.NewFastingCmd(store))
+	rootCmd.AddCommand(meal.NewMealCmd(store))
 	rootCmd.AddCommand(soda.NewSodaCmd(store))
 
 	return rootCmd.Execute()
@@ -0,0 +1,77 @@
+// cmd/tracker/commands/soda/add.go
+package soda
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Parse and validate date
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		// Without --date the record is for today, not the current moment
+		if flags.date == "" {
+			date = models.CalendarDate(date)
+		}
+
+		if err := validator.ValidateNotes(flags.notes); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Create record
+		record := models.SodaRecord{
+			Date:     date,
+			Consumed: flags.consumed,
+			Quantity: flags.quantity,
+			Notes:    flags.notes,
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Try to add record
+		if err := store.AddSoda(record); err != nil {
+			if err.Error() == "duplicate_date" {
+				display.ShowWarning("Record already exists for %s", date.Format(validator.DateFormat))
+				confirmResult := display.ConfirmAction("Do you want to overwrite this record?")
+				if !confirmResult.Confirmed {
+					display.ShowInfo("Operation cancelled")
+					return result.NewError(fmt.Errorf("operation cancelled")).Error
+				}
+				// If confirmed, try to update instead
+				existingRecord, _ := store.GetSoda(date)
+				if existingRecord != nil {
+					if err := store.UpdateSoda(existingRecord.Date, record); err != nil {
+						return result.StorageError(err).Error
+					}
+				}
+			} else {
+				return result.StorageError(err).Error
+			}
+		}
+
+		// Show the record against the allowance rules in force
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Use CommandResult for success
+		cmdResult := result.NewSuccess(models.SodaWithAllowance{Record: record, Allowance: settings.GetSodaAllowance()},
+			"Soda record added successfully")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
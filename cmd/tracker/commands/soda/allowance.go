@@ -0,0 +1,132 @@
+// cmd/tracker/commands/soda/allowance.go
+package soda
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newAllowanceCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance",
+		Short: "Show or change the soda allowance rules",
+		Long: `Show or change the soda allowance rules.
+
+Each day has a cap in ounces. An optional weekly budget limits the total across
+Monday-Sunday, and with rollover any budget left unused in one week is added to
+the next.
+
+Examples:
+  # Show the current rules
+  tracker soda allowance show
+
+  # No soda on weekdays, 12 oz per weekend day, 24 oz per week
+  tracker soda allowance set --weekday-cap 0 --weekend-cap 12 --budget 24
+
+  # Allow a small Wednesday treat and carry unused budget forward
+  tracker soda allowance set --wednesday 8 --rollover`,
+	}
+
+	cmd.AddCommand(
+		newAllowanceShowCmd(store),
+		newAllowanceSetCmd(store),
+	)
+
+	return cmd
+}
+
+func newAllowanceShowCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the soda allowance rules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			display.ShowSodaAllowance(settings.GetSodaAllowance())
+			return nil
+		},
+	}
+}
+
+func newAllowanceSetCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Change the soda allowance rules",
+		RunE:  createAllowanceSetCmdRunner(store),
+	}
+
+	cmd.Flags().Float64Var(&flags.weekdayCap, "weekday-cap", 0, "Daily cap in ounces for Monday-Thursday")
+	cmd.Flags().Float64Var(&flags.weekendCap, "weekend-cap", 0, "Daily cap in ounces for Friday-Sunday")
+	cmd.Flags().Float64VarP(&flags.weeklyBudget, "budget", "b", 0, "Weekly budget in ounces (0 for none)")
+	cmd.Flags().BoolVarP(&flags.rollover, "rollover", "r", false, "Carry unused weekly budget into the next week")
+
+	flags.dailyCaps = make(map[time.Weekday]*float64)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		flags.dailyCaps[day] = cmd.Flags().Float64(name, 0, fmt.Sprintf("Daily cap in ounces for %s", day))
+	}
+
+	return cmd
+}
+
+func createAllowanceSetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		allowance := settings.GetSodaAllowance()
+
+		if cmd.Flags().NFlag() == 0 {
+			return result.ValidationFailed(fmt.Errorf("specify at least one cap, --budget or --rollover")).Error
+		}
+
+		// Apply group caps first so individual days can override them
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			isWeekend := day == time.Friday || day == time.Saturday || day == time.Sunday
+			if !isWeekend && cmd.Flags().Changed("weekday-cap") {
+				allowance.SetDailyCap(day, flags.weekdayCap)
+			}
+			if isWeekend && cmd.Flags().Changed("weekend-cap") {
+				allowance.SetDailyCap(day, flags.weekendCap)
+			}
+		}
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if cmd.Flags().Changed(strings.ToLower(day.String())) {
+				allowance.SetDailyCap(day, *flags.dailyCaps[day])
+			}
+		}
+
+		if cmd.Flags().Changed("budget") {
+			allowance.WeeklyBudget = flags.weeklyBudget
+			if flags.weeklyBudget == 0 {
+				allowance.Rollover = false
+			}
+		}
+		if cmd.Flags().Changed("rollover") {
+			allowance.Rollover = flags.rollover
+		}
+
+		if err := allowance.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.SodaAllowance = &allowance
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil, "Soda allowance updated successfully"))
+		display.ShowSodaAllowance(allowance)
+
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+// cmd/tracker/commands/soda/beverage.go
+package soda
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newBeverageCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "beverage",
+		Short: "Manage the beverage catalog",
+		Long: `Manage the catalog of beverages that drinks are logged against.
+
+Caffeine and sugar are given per fluid ounce, so a 12 oz can of cola with
+34 mg caffeine and 39 g sugar is about 2.8 mg and 3.25 g per oz.
+
+Examples:
+  # Add or change a beverage
+  tracker soda beverage add "Coke" --caffeine 2.8 --sugar 3.25
+
+  # List the catalog
+  tracker soda beverage list
+
+  # Remove a beverage
+  tracker soda beverage remove "Coke"`,
+	}
+
+	cmd.AddCommand(
+		newBeverageAddCmd(store),
+		newBeverageListCmd(store),
+		newBeverageRemoveCmd(store),
+	)
+
+	return cmd
+}
+
+func newBeverageAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Add or update a beverage",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			beverage := models.Beverage{
+				Name:          args[0],
+				CaffeinePerOz: flags.caffeinePerOz,
+				SugarPerOz:    flags.sugarPerOz,
+			}
+			if err := beverage.Validate(); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+
+			message := "Beverage added successfully"
+			if settings.Beverages.Find(beverage.Name) != nil {
+				message = "Beverage updated successfully"
+			}
+			settings.Beverages.Set(beverage)
+
+			if err := store.SaveSettings(settings); err != nil {
+				return result.StorageError(err).Error
+			}
+
+			display.ShowCommandResult(result.NewSuccess(nil, message))
+			display.ShowBeverageCatalog(settings.Beverages)
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64Var(&flags.caffeinePerOz, "caffeine", 0, "Caffeine in mg per oz")
+	cmd.Flags().Float64Var(&flags.sugarPerOz, "sugar", 0, "Sugar in g per oz")
+
+	return cmd
+}
+
+func newBeverageListCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the beverage catalog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			if len(settings.Beverages) == 0 {
+				return result.NewError(fmt.Errorf("No beverages in the catalog; add one with 'tracker soda beverage add'")).Error
+			}
+			display.ShowBeverageCatalog(settings.Beverages)
+			return nil
+		},
+	}
+}
+
+func newBeverageRemoveCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove NAME",
+		Short: "Remove a beverage from the catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings, err := store.GetSettings()
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+
+			// Logged drinks keep their own copy of the nutrition values
+			if err := settings.Beverages.Remove(args[0]); err != nil {
+				return result.NotFound("Beverage", args[0]).Error
+			}
+
+			if err := store.SaveSettings(settings); err != nil {
+				return result.StorageError(err).Error
+			}
+
+			display.ShowCommandResult(result.NewSuccess(nil, "Beverage removed successfully"))
+			return nil
+		},
+	}
+}
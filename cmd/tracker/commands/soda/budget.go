@@ -0,0 +1,97 @@
+// cmd/tracker/commands/soda/budget.go
+package soda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newBudgetCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget",
+		Short: "Show soda used versus remaining for the week",
+		RunE:  createBudgetCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Any date within the week to show (default: today)")
+
+	return cmd
+}
+
+func createBudgetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		allowance := settings.GetSodaAllowance()
+
+		// Load this week and the week before for rollover
+		weekStart := models.WeekStart(date)
+		weekEnd := weekStart.AddDate(0, 0, 6)
+		records, err := store.GetSodaRange(weekStart.AddDate(0, 0, -7), weekEnd, false)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// The week is included even before anything is logged, so rollover still applies
+		week := allowance.Week(records, weekStart)
+
+		// Without a weekly budget the week is limited by the sum of the daily caps
+		budgetSource := "weekly budget"
+		if allowance.WeeklyBudget == 0 {
+			week.Budget = 0
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				week.Budget += allowance.DailyCap(day)
+			}
+			budgetSource = "sum of daily caps"
+		}
+
+		display.ShowHeader(fmt.Sprintf("Soda Budget for %s to %s",
+			weekStart.Format(validator.DateFormat),
+			weekEnd.Format(validator.DateFormat)))
+
+		var weekRecords []models.SodaRecord
+		for _, record := range records {
+			if !record.Date.Before(weekStart) {
+				weekRecords = append(weekRecords, record)
+			}
+		}
+		if len(weekRecords) > 0 {
+			compliance, err := evaluateCompliance(store, allowance, weekRecords, weekStart, weekEnd)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			display.ShowSodaList(weekRecords, compliance, allowance)
+		}
+
+		stats := map[string]string{
+			"Budget":    fmt.Sprintf("%.1f oz (%s)", week.Budget, budgetSource),
+			"Used":      fmt.Sprintf("%.1f oz", week.Used),
+			"Remaining": fmt.Sprintf("%.1f oz", week.Remaining()),
+		}
+		if allowance.Rollover {
+			stats["Rolled Over"] = fmt.Sprintf("%.1f oz", week.Carried)
+			stats["Available"] = fmt.Sprintf("%.1f oz", week.Available())
+		}
+		display.ShowStats(stats)
+
+		if week.Remaining() < 0 {
+			display.ShowWarning("Weekly budget exceeded by %.1f oz", -week.Remaining())
+		}
+
+		return nil
+	}
+}
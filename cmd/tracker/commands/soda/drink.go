@@ -0,0 +1,287 @@
+// cmd/tracker/commands/soda/drink.go
+package soda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newDrinkCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drink",
+		Short: "Log individual drinks",
+		Long: `Log individual drinks from the beverage catalog. Each day's soda record is
+derived from its drinks: the ounces are summed and caffeine and sugar are
+worked out from the catalog. A day whose record was entered by hand with
+'soda add' or 'soda update' keeps that record; its drinks are still logged.
+
+Examples:
+  # Log a 12 oz Coke now
+  tracker soda drink add --beverage Coke --size 12
+
+  # Log a drink on an earlier day
+  tracker soda drink add --beverage Coke --size 8 --date 2024-01-08 --at 15:30
+
+  # List drinks
+  tracker soda drink list --from 2024-01-01 --to 2024-01-08
+
+  # Delete a drink
+  tracker soda drink delete --date 2024-01-08 --at 15:30`,
+	}
+
+	cmd.AddCommand(
+		newDrinkAddCmd(store),
+		newDrinkListCmd(store),
+		newDrinkDeleteCmd(store),
+	)
+
+	return cmd
+}
+
+func newDrinkAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Log a drink",
+		RunE:  createDrinkAddCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.beverage, "beverage", "b", "", "Beverage name from the catalog (required)")
+	cmd.Flags().Float64VarP(&flags.size, "size", "s", 0, "Size in ounces (required)")
+	cmd.Flags().StringVar(&flags.at, "at", "", "Time of the drink as HH:MM (default: now)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the drink (default: today)")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the drink")
+	cmd.MarkFlagRequired("beverage")
+	cmd.MarkFlagRequired("size")
+
+	return cmd
+}
+
+func createDrinkAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		at, err := parseDrinkTime(flags.date, flags.at)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := validator.ValidateNotes(flags.notes); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		beverage := settings.Beverages.Find(flags.beverage)
+		if beverage == nil {
+			return result.ValidationFailed(fmt.Errorf("unknown beverage: %s (add it with 'tracker soda beverage add')", flags.beverage)).Error
+		}
+
+		entry := models.NewSodaEntry(at, *beverage, flags.size, flags.notes)
+		if err := entry.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		if err := store.AddSodaEntry(entry); err != nil {
+			if err.Error() == "duplicate_date" {
+				return result.NewError(fmt.Errorf("a drink is already logged at %s",
+					at.Format(validator.DateTimeFormat))).Error
+			}
+			return result.StorageError(err).Error
+		}
+
+		record, derived, err := applySodaEntries(store, models.CalendarDate(at))
+		if err != nil {
+			// Don't keep a drink that would leave the day's record invalid
+			if deleteErr := store.DeleteSodaEntry(entry.Time); deleteErr != nil {
+				return result.StorageError(deleteErr).Error
+			}
+			return err
+		}
+
+		message := fmt.Sprintf("%s total: %.1f oz, %.0f mg caffeine, %.1f g sugar",
+			record.Date.Format(validator.DateFormat), record.Quantity, record.Caffeine, record.Sugar)
+		if !derived {
+			message = manualRecordMessage(record)
+		}
+
+		cmdResult := result.NewSuccess(entry, "Drink logged successfully", message)
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
+
+func newDrinkListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List logged drinks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var fromDate, toDate time.Time
+			var err error
+
+			if flags.fromDate == "" && flags.toDate == "" {
+				fromDate, toDate = validator.GetDefaultDateRange()
+			} else {
+				fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+				if err != nil {
+					return result.ValidationFailed(err).Error
+				}
+			}
+
+			rangeStart, _ := models.LocalDayBounds(fromDate)
+			_, rangeEnd := models.LocalDayBounds(toDate)
+			entries, err := store.GetSodaEntryRange(rangeStart, rangeEnd)
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+
+			if len(entries) == 0 {
+				return result.NewError(fmt.Errorf("No drinks found between %s and %s",
+					fromDate.Format(validator.DateFormat),
+					toDate.Format(validator.DateFormat))).Error
+			}
+
+			display.ShowHeader(fmt.Sprintf("Drinks from %s to %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat)))
+			display.ShowSodaEntryList(entries)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date for listing drinks")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing drinks")
+
+	return cmd
+}
+
+func newDrinkDeleteCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a logged drink",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			at, err := parseDrinkTime(flags.date, flags.at)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+
+			// Find the drink logged in that minute
+			entries, err := store.GetSodaEntryRange(at, at.Add(time.Minute))
+			if err != nil {
+				return result.StorageError(err).Error
+			}
+			if len(entries) == 0 {
+				return result.NotFound("Drink", at.Format(validator.DateTimeFormat)).Error
+			}
+			entry := entries[0]
+
+			display.ShowSodaEntry(entry)
+			if !display.ConfirmAction("Are you sure you want to delete this drink?").Confirmed {
+				display.ShowInfo("Operation cancelled")
+				return result.NewError(fmt.Errorf("operation cancelled")).Error
+			}
+
+			if err := store.DeleteSodaEntry(entry.Time); err != nil {
+				return result.StorageError(err).Error
+			}
+
+			record, derived, err := applySodaEntries(store, models.CalendarDate(entry.Time))
+			if err != nil {
+				return err
+			}
+
+			message := fmt.Sprintf("%s total: %.1f oz", record.Date.Format(validator.DateFormat), record.Quantity)
+			if !derived {
+				message = manualRecordMessage(record)
+			}
+
+			display.ShowCommandResult(result.NewSuccess(nil, "Drink deleted successfully", message))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.at, "at", "", "Time of the drink to delete as HH:MM (required)")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the drink (default: today)")
+	cmd.MarkFlagRequired("at")
+
+	return cmd
+}
+
+// parseDrinkTime combines the optional --date with the --at clock time
+func parseDrinkTime(date, at string) (time.Time, error) {
+	if date == "" {
+		return validator.ParseDateTime(at)
+	}
+	if _, err := validator.ParseDate(date); err != nil {
+		return time.Time{}, err
+	}
+	if at == "" {
+		return time.Time{}, fmt.Errorf("--at is required when --date is given")
+	}
+	return validator.ParseDateTime(date + " " + at)
+}
+
+// applySodaEntries derives a day's soda record from its logged drinks,
+// creating the record if it doesn't exist yet and removing it once its last
+// drink is deleted. A record entered by hand is left alone and returned with
+// false. Errors are returned as command results.
+func applySodaEntries(store storage.StorageManager, day time.Time) (models.SodaRecord, bool, error) {
+	dayStart, dayEnd := models.LocalDayBounds(day)
+	entries, err := store.GetSodaEntryRange(dayStart, dayEnd)
+	if err != nil {
+		return models.SodaRecord{}, false, result.StorageError(err).Error
+	}
+
+	existing, err := store.GetSoda(day)
+	if err != nil {
+		return models.SodaRecord{}, false, result.StorageError(err).Error
+	}
+
+	if existing != nil && !existing.IsDerived() {
+		return *existing, false, nil
+	}
+
+	if len(entries) == 0 {
+		record := models.SodaRecord{Date: day}
+		if existing != nil {
+			if err := store.DeleteSoda(existing.Date); err != nil {
+				return record, true, result.StorageError(err).Error
+			}
+		}
+		return record, true, nil
+	}
+
+	record := models.SodaRecord{Date: day}
+	if existing != nil {
+		record = *existing
+	}
+	record.ApplyEntries(entries)
+	if err := record.Validate(); err != nil {
+		return record, true, result.ValidationFailed(err).Error
+	}
+
+	if existing == nil {
+		err = store.AddSoda(record)
+	} else {
+		err = store.UpdateSoda(existing.Date, record)
+	}
+	if err != nil {
+		return record, true, result.StorageError(err).Error
+	}
+	return record, true, nil
+}
+
+// manualRecordMessage explains why a day's drinks didn't change its record
+func manualRecordMessage(record models.SodaRecord) string {
+	return fmt.Sprintf("%s was entered by hand as %.1f oz and was not changed (use 'tracker soda update' to change it)",
+		record.Date.Format(validator.DateFormat), record.Quantity)
+}
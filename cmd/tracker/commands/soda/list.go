@@ -0,0 +1,236 @@
+// cmd/tracker/commands/soda/list.go
+package soda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+type sodaStats struct {
+	TotalRecords     int
+	CompliantRecords int
+	ConsumedDays     int
+	TotalOunces      float64
+	WeekdayOunces    float64
+	WeekendOunces    float64
+	TotalCaffeine    float64
+	TotalSugar       float64
+}
+
+func newListCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List soda records",
+		RunE:  createListCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.fromDate, "from", "f", "", "Start date for listing soda records")
+	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing soda records")
+	cmd.Flags().BoolVarP(&flags.lastWeek, "week", "w", false, "Show last 7 days")
+	cmd.Flags().BoolVarP(&flags.lastMonth, "month", "m", false, "Show last month")
+	cmd.Flags().BoolVar(&flags.nutrition, "nutrition", false, "Show caffeine and sugar per day and per week")
+
+	return cmd
+}
+
+func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var fromDate, toDate time.Time
+		var err error
+		var isDefaultRange bool
+
+		// Handle date range selection
+		switch {
+		case flags.lastWeek:
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-14")
+				fromDate = toDate.AddDate(0, 0, -7)
+			} else {
+				toDate = time.Now()
+				fromDate = toDate.AddDate(0, 0, -7)
+			}
+			isDefaultRange = false
+		case flags.lastMonth:
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-31")
+				fromDate, _ = time.Parse(validator.DateFormat, "2024-01-01")
+			} else {
+				toDate = time.Now()
+				fromDate = toDate.AddDate(0, -1, 0)
+			}
+			isDefaultRange = false
+		case flags.fromDate == "" && flags.toDate == "":
+			if store.IsTestMode() {
+				// In test mode, use fixed date range
+				toDate, _ = time.Parse(validator.DateFormat, "2024-01-31")
+				fromDate = toDate.AddDate(0, 0, -30)
+			} else {
+				fromDate, toDate = validator.GetDefaultDateRange()
+			}
+			isDefaultRange = true
+		default:
+			fromDate, toDate, err = validator.ValidateDateRange(flags.fromDate, flags.toDate)
+			if err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			isDefaultRange = false
+		}
+
+		// Get records
+		records, err := store.GetSodaRange(fromDate, toDate, isDefaultRange)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(records) == 0 {
+			return result.NewError(fmt.Errorf("No soda records found between %s and %s",
+				fromDate.Format(validator.DateFormat),
+				toDate.Format(validator.DateFormat))).Error
+		}
+
+		// Judge compliance against the daily caps and the weekly budget
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		allowance := settings.GetSodaAllowance()
+		compliance, err := evaluateCompliance(store, allowance, records, fromDate, toDate)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		// Calculate statistics
+		stats := calculateSodaStats(records, compliance)
+
+		// Display results
+		display.ShowHeader(fmt.Sprintf("Soda Records from %s to %s",
+			fromDate.Format(validator.DateFormat),
+			toDate.Format(validator.DateFormat)))
+
+		display.ShowSodaList(records, compliance, allowance)
+
+		if flags.nutrition {
+			showNutrition(records)
+		}
+
+		summary := map[string]string{
+			"Total Records":   fmt.Sprintf("%d", stats.TotalRecords),
+			"Days With Soda":  fmt.Sprintf("%d", stats.ConsumedDays),
+			"Total Ounces":    fmt.Sprintf("%.1f oz", stats.TotalOunces),
+			"Weekday Ounces":  fmt.Sprintf("%.1f oz", stats.WeekdayOunces),
+			"Weekend Ounces":  fmt.Sprintf("%.1f oz", stats.WeekendOunces),
+			"Average Per Day": fmt.Sprintf("%.1f oz", stats.TotalOunces/float64(stats.TotalRecords)),
+			"Compliant Days":  fmt.Sprintf("%d", stats.CompliantRecords),
+			"Compliance Rate": fmt.Sprintf("%.1f%%", float64(stats.CompliantRecords)/float64(stats.TotalRecords)*100),
+		}
+		if flags.nutrition {
+			summary["Total Caffeine"] = fmt.Sprintf("%.0f mg", stats.TotalCaffeine)
+			summary["Total Sugar"] = fmt.Sprintf("%.1f g", stats.TotalSugar)
+		}
+		display.ShowStats(summary)
+
+		return nil
+	}
+}
+
+// evaluateCompliance checks each record against its daily cap and the weekly
+// budget, loading the surrounding weeks so running totals and rollover are correct
+func evaluateCompliance(store storage.StorageManager, allowance models.SodaAllowance, records []models.SodaRecord, fromDate, toDate time.Time) ([]bool, error) {
+	overBudget := map[time.Time]bool{}
+	if allowance.WeeklyBudget > 0 {
+		context, err := store.GetSodaRange(models.WeekStart(fromDate).AddDate(0, 0, -7), toDate, false)
+		if err != nil {
+			return nil, err
+		}
+		overBudget = allowance.OverBudget(context)
+	}
+
+	compliance := make([]bool, len(records))
+	for i, record := range records {
+		compliance[i] = record.IsCompliant(allowance) && !overBudget[record.Date]
+	}
+	return compliance, nil
+}
+
+func calculateSodaStats(records []models.SodaRecord, compliance []bool) sodaStats {
+	stats := sodaStats{
+		TotalRecords: len(records),
+	}
+
+	for i, record := range records {
+		if compliance[i] {
+			stats.CompliantRecords++
+		}
+		if !record.Consumed {
+			continue
+		}
+		stats.ConsumedDays++
+		stats.TotalOunces += record.Quantity
+		stats.TotalCaffeine += record.Caffeine
+		stats.TotalSugar += record.Sugar
+		if record.IsWeekend() {
+			stats.WeekendOunces += record.Quantity
+		} else {
+			stats.WeekdayOunces += record.Quantity
+		}
+	}
+
+	return stats
+}
+
+// showNutrition reports caffeine and sugar per day and per Monday-Sunday week.
+// Days logged without drink entries have no nutrition data and count as zero.
+func showNutrition(records []models.SodaRecord) {
+	display.ShowHeader("Daily Caffeine and Sugar")
+	var dailyRows [][]string
+	for _, record := range records {
+		if !record.Consumed {
+			continue
+		}
+		dailyRows = append(dailyRows, []string{
+			record.Date.Format(validator.DateFormat),
+			fmt.Sprintf("%d", record.Entries),
+			fmt.Sprintf("%.1f", record.Quantity),
+			fmt.Sprintf("%.0f", record.Caffeine),
+			fmt.Sprintf("%.1f", record.Sugar),
+		})
+	}
+	display.ShowTable([]string{"Date", "Drinks", "Ounces", "Caffeine (mg)", "Sugar (g)"}, dailyRows)
+
+	type weekTotals struct {
+		ounces, caffeine, sugar float64
+	}
+	var weeks []time.Time
+	totals := make(map[time.Time]*weekTotals)
+	for _, record := range records {
+		start := models.WeekStart(record.Date)
+		if totals[start] == nil {
+			weeks = append(weeks, start)
+			totals[start] = &weekTotals{}
+		}
+		totals[start].ounces += record.Quantity
+		totals[start].caffeine += record.Caffeine
+		totals[start].sugar += record.Sugar
+	}
+
+	display.ShowHeader("Weekly Caffeine and Sugar")
+	weeklyRows := make([][]string, len(weeks))
+	for i, start := range weeks {
+		weeklyRows[i] = []string{
+			start.Format(validator.DateFormat),
+			fmt.Sprintf("%.1f", totals[start].ounces),
+			fmt.Sprintf("%.0f", totals[start].caffeine),
+			fmt.Sprintf("%.1f", totals[start].sugar),
+		}
+	}
+	display.ShowTable([]string{"Week Of", "Ounces", "Caffeine (mg)", "Sugar (g)"}, weeklyRows)
+}
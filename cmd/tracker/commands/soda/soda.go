@@ -2,13 +2,114 @@
 package soda
 
 import (
+	"time"
+
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/spf13/cobra"
 )
 
+// Shared flags across soda commands
+type sodaFlags struct {
+	// Basic flags for add/update
+	consumed    bool
+	notConsumed bool
+	quantity    float64
+	date        string
+	notes       string
+
+	// List command flags
+	fromDate  string
+	toDate    string
+	lastWeek  bool
+	lastMonth bool
+
+	// Drink and beverage command flags
+	beverage      string
+	size          float64
+	at            string
+	caffeinePerOz float64
+	sugarPerOz    float64
+	nutrition     bool
+
+	// Allowance command flags
+	dailyCaps    map[time.Weekday]*float64
+	weekdayCap   float64
+	weekendCap   float64
+	weeklyBudget float64
+	rollover     bool
+}
+
+var flags sodaFlags
+
+// NewSodaCmd creates the soda command and all its subcommands
 func NewSodaCmd(store storage.StorageManager) *cobra.Command {
-	return &cobra.Command{
+	sodaCmd := &cobra.Command{
 		Use:   "soda",
 		Short: "Manage soda consumption records",
+		Long: `Manage soda consumption records with full CRUD operations.
+
+Examples:
+  # Record soda consumed on a day
+  tracker soda add --consumed --quantity 12 --date 2024-01-08
+
+  # Record a soda-free day
+  tracker soda add --date 2024-01-09
+
+  # Get soda record for a specific date
+  tracker soda get --date 2024-01-08
+
+  # List soda records with compliance
+  tracker soda list --from 2024-01-01 --to 2024-01-08
+
+  # Update a soda record
+  tracker soda update --date 2024-01-08 --quantity 8
+
+  # Delete a soda record
+  tracker soda delete --date 2024-01-08
+
+  # Allow 12 oz on weekends within a 24 oz weekly budget
+  tracker soda allowance set --weekend-cap 12 --budget 24 --rollover
+
+  # Show used versus remaining for the current week
+  tracker soda budget
+
+  # Log individual drinks from a beverage catalog
+  tracker soda beverage add "Coke" --caffeine 2.8 --sugar 3.25
+  tracker soda drink add --beverage Coke --size 12
+
+  # Report caffeine and sugar per day and per week
+  tracker soda list --nutrition`,
+	}
+
+	// Add subcommands
+	sodaCmd.AddCommand(
+		newAddCmd(store),
+		newGetCmd(store),
+		newListCmd(store),
+		newUpdateCmd(store),
+		newDeleteCmd(store),
+		newAllowanceCmd(store),
+		newBudgetCmd(store),
+		newBeverageCmd(store),
+		newDrinkCmd(store),
+	)
+
+	return sodaCmd
+}
+
+// Add command implementation
+func newAddCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a new soda record",
+		RunE:  createAddCmdRunner(store),
 	}
+
+	// Add flags
+	cmd.Flags().BoolVarP(&flags.consumed, "consumed", "c", false, "Soda was consumed on this day")
+	cmd.Flags().Float64VarP(&flags.quantity, "quantity", "q", 0, "Quantity consumed in ounces")
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of soda record (default: today)")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the soda record")
+
+	return cmd
 }
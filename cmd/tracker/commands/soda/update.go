@@ -0,0 +1,116 @@
+// cmd/tracker/commands/soda/update.go
+package soda
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newUpdateCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a soda record",
+		RunE:  createUpdateCmdRunner(store),
+	}
+
+	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of the soda record to update (required)")
+	cmd.Flags().BoolVarP(&flags.consumed, "consumed", "c", false, "Mark soda as consumed")
+	cmd.Flags().BoolVar(&flags.notConsumed, "not-consumed", false, "Mark day as soda-free (clears quantity)")
+	cmd.Flags().Float64VarP(&flags.quantity, "quantity", "q", 0, "Updated quantity in ounces")
+	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Updated notes")
+
+	cmd.MarkFlagRequired("date")
+
+	return cmd
+}
+
+func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Parse and validate date
+		date, err := validator.ParseDate(flags.date)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Get existing record
+		record, err := store.GetSoda(date)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if record == nil {
+			return result.NotFound("Soda record", flags.date).Error
+		}
+
+		// Store original quantity for comparison
+		originalQuantity := record.Quantity
+
+		// Handle consumption status
+		if cmd.Flags().Changed("consumed") && cmd.Flags().Changed("not-consumed") {
+			return result.ValidationFailed(fmt.Errorf("cannot use both --consumed and --not-consumed flags")).Error
+		}
+		if cmd.Flags().Changed("consumed") {
+			record.Consumed = true
+		}
+		if cmd.Flags().Changed("not-consumed") {
+			record.Consumed = false
+			record.Quantity = 0
+		}
+
+		// A quantity on its own says whether soda was consumed
+		if cmd.Flags().Changed("quantity") {
+			if cmd.Flags().Changed("not-consumed") {
+				return result.ValidationFailed(fmt.Errorf("cannot use --quantity with --not-consumed")).Error
+			}
+			record.Quantity = flags.quantity
+			record.Consumed = flags.quantity > 0
+		}
+
+		// A quantity entered by hand replaces any total derived from drinks
+		if record.Quantity != originalQuantity {
+			record.Entries = 0
+		}
+
+		if cmd.Flags().Changed("notes") {
+			if err := validator.ValidateNotes(flags.notes); err != nil {
+				return result.ValidationFailed(err).Error
+			}
+			record.Notes = flags.notes
+		}
+
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Display summary of changes
+		if originalQuantity != record.Quantity {
+			display.ShowHeader("\nUpdate Summary:")
+			fmt.Printf("Quantity: %.1f -> %.1f oz\n", originalQuantity, record.Quantity)
+			if !display.ConfirmAction("Do you want to apply these changes?").Confirmed {
+				display.ShowInfo("Operation cancelled")
+				return result.NewError(fmt.Errorf("operation cancelled")).Error
+			}
+		}
+
+		// Perform update
+		if err := store.UpdateSoda(record.Date, *record); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		cmdResult := result.NewSuccess(models.SodaWithAllowance{Record: *record, Allowance: settings.GetSodaAllowance()},
+			"Soda record updated successfully")
+		display.ShowCommandResult(cmdResult)
+
+		return nil
+	}
+}
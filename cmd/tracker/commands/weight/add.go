@@ -3,6 +3,7 @@ package weight
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
@@ -20,9 +21,12 @@ func newAddCmd(store storage.StorageManager) *cobra.Command {
 	}
 
 	// Add flags
-	cmd.Flags().Float64VarP(&flags.value, "value", "v", 0, "Weight value in pounds (required)")
+	cmd.Flags().Float64VarP(&flags.value, "value", "v", 0, "Weight value in the chosen unit (required)")
 	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date of weight record (default: today)")
+	cmd.Flags().StringVar(&flags.at, "at", "", "Time of the weigh-in as HH:MM (default: now, or none with --date)")
 	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Optional notes about the weight record")
+	addUnitFlag(cmd)
+	addCompositionFlags(cmd)
 	cmd.MarkFlagRequired("value")
 
 	return cmd
@@ -31,34 +35,48 @@ func newAddCmd(store storage.StorageManager) *cobra.Command {
 // cmd/tracker/commands/weight/add.go
 func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		date, err := validator.ParseDate(flags.date)
+		date, at, err := parseWeighInTime(flags.date, flags.at)
 		if err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Weights are stored in pounds whatever unit they were entered in
 		record := models.WeightRecord{
 			Date:   date,
-			Weight: flags.value,
+			Time:   at,
+			Weight: unit.ToPounds(flags.value),
 			Notes:  flags.notes,
 		}
+		applyComposition(cmd, &record, unit)
 
 		// Basic validation
-		if err := validateWeightRange(record.Weight); err != nil {
+		if err := validateWeightRange(record.Weight, unit); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if err := record.Validate(); err != nil {
 			return result.ValidationFailed(err).Error
 		}
 
 		// Try to add record
 		savedRecord, err := store.AddWeight(record)
 		if err != nil {
-			if err.Error() == "duplicate_date" {
-				display.ShowWarning("Record already exists for %s", date.Format(validator.DateFormat))
+			if err.Error() == "duplicate_weigh_in" {
+				display.ShowWarning("Record already exists for %s", record.FormatWhen())
 				confirmResult := display.ConfirmAction("Do you want to overwrite this record?")
 				if !confirmResult.Confirmed {
 					display.ShowInfo("Operation cancelled")
 					return result.NewError(fmt.Errorf("operation cancelled")).Error
 				}
 				// If confirmed, use UpdateWeight instead
-				existingRecord, _ := store.GetWeight(date)
+				existingRecord, err := findWeighIn(store, record)
+				if err != nil {
+					return result.StorageError(err).Error
+				}
 				if existingRecord != nil {
 					record.ID = existingRecord.ID
 					if err := store.UpdateWeight(record.ID, record); err != nil {
@@ -71,10 +89,59 @@ func createAddCmdRunner(store storage.StorageManager) func(*cobra.Command, []str
 			}
 		}
 
+		messages := []string{"Weight record added successfully"}
+		goal, err := goalMessage(store, savedRecord, unit)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if goal != "" {
+			messages = append(messages, goal)
+		}
+
 		// Use CommandResult for success
-		cmdResult := result.NewSuccess(savedRecord, "Weight record added successfully")
+		cmdResult := result.NewSuccess(models.WeightInUnit{Record: savedRecord, Unit: unit}, messages...)
 		display.ShowCommandResult(cmdResult)
 
 		return nil
 	}
 }
+
+// parseWeighInTime returns the date of a weigh-in and its time of day. The
+// time is now without --date or --at, and none with only --date.
+func parseWeighInTime(date, at string) (time.Time, *time.Time, error) {
+	if at == "" {
+		if date != "" {
+			day, err := validator.ParseDate(date)
+			return day, nil, err
+		}
+		now := time.Now().Truncate(time.Minute)
+		return models.CalendarDate(now), &now, nil
+	}
+
+	value := at
+	if date != "" {
+		if _, err := validator.ParseDate(date); err != nil {
+			return time.Time{}, nil, err
+		}
+		value = date + " " + at
+	}
+	taken, err := validator.ParseDateTime(value)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return models.CalendarDate(taken), &taken, nil
+}
+
+// findWeighIn returns the stored weigh-in at the same day and time as a record
+func findWeighIn(store storage.StorageManager, record models.WeightRecord) (*models.WeightRecord, error) {
+	records, err := store.GetWeightRange(record.Day(), record.Day(), false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if records[i].SameWeighIn(record) {
+			return &records[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+// cmd/tracker/commands/weight/composition.go
+package weight
+
+import (
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// addCompositionFlags adds the smart scale readings to a weight command
+func addCompositionFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64Var(&flags.bodyFat, "body-fat", 0, "Body fat percentage")
+	cmd.Flags().Float64Var(&flags.muscleMass, "muscle", 0, "Muscle mass in the chosen unit")
+	cmd.Flags().Float64Var(&flags.water, "water", 0, "Body water percentage")
+	cmd.Flags().Float64Var(&flags.visceralFat, "visceral-fat", 0, "Visceral fat rating")
+}
+
+// applyComposition sets the readings given on the command line. A reading of
+// 0 clears it.
+func applyComposition(cmd *cobra.Command, record *models.WeightRecord, unit models.WeightUnit) {
+	if cmd.Flags().Changed("body-fat") {
+		record.BodyFat = flags.bodyFat
+	}
+	if cmd.Flags().Changed("muscle") {
+		record.MuscleMass = unit.ToPounds(flags.muscleMass)
+	}
+	if cmd.Flags().Changed("water") {
+		record.Water = flags.water
+	}
+	if cmd.Flags().Changed("visceral-fat") {
+		record.VisceralFat = flags.visceralFat
+	}
+}
+
+// compositionTrend returns the smoothed fat and lean mass on each listed
+// record's day, nil without body fat measured that day, and the listed days'
+// trends in date order. history holds one record per day.
+func compositionTrend(records, history []models.WeightRecord, smoothing float64) ([]*models.CompositionTrend, []models.CompositionTrend) {
+	byDay := make(map[time.Time]models.CompositionTrend, len(history))
+	for _, point := range models.ComputeCompositionTrend(history, smoothing) {
+		date := point.FatMass.Date
+		byDay[time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)] = point
+	}
+
+	trend := make([]*models.CompositionTrend, len(records))
+	var listed []models.CompositionTrend
+	seen := make(map[time.Time]bool)
+	for i, record := range records {
+		point, ok := byDay[record.Day()]
+		if !ok {
+			continue
+		}
+		trend[i] = &point
+		if !seen[record.Day()] {
+			seen[record.Day()] = true
+			listed = append(listed, point)
+		}
+	}
+	return trend, listed
+}
@@ -0,0 +1,79 @@
+// cmd/tracker/commands/weight/daily.go
+package weight
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newDailyCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "daily [first|min|average]",
+		Short: "Show or set how a day's weight is chosen from several weigh-ins",
+		Long: `Show or set which weight counts for a day with several weigh-ins.
+
+The day's weight is used for the trend, the goal projection and daily lists:
+  first    the earliest weigh-in, usually on waking (default)
+  min      the lightest weigh-in
+  average  the mean of the day's weigh-ins`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: createDailyCmdRunner(store),
+	}
+}
+
+func createDailyCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(args) == 0 {
+			display.ShowInfo("Daily weight is the %s", policyName(settings.GetDailyWeightPolicy()))
+			return nil
+		}
+
+		policy, err := models.ParseDailyWeightPolicy(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.DailyWeight = policy
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil,
+			fmt.Sprintf("Daily weight set to the %s", policyName(policy))))
+
+		return nil
+	}
+}
+
+// dailyPolicy returns the policy given with --policy, or the saved policy
+func dailyPolicy(cmd *cobra.Command, store storage.StorageManager) (models.DailyWeightPolicy, error) {
+	if cmd.Flags().Changed("policy") {
+		return models.ParseDailyWeightPolicy(flags.policy)
+	}
+	settings, err := store.GetSettings()
+	if err != nil {
+		return "", err
+	}
+	return settings.GetDailyWeightPolicy(), nil
+}
+
+// policyName describes a policy for messages
+func policyName(policy models.DailyWeightPolicy) string {
+	switch policy {
+	case models.DailyMin:
+		return "lightest weigh-in of the day"
+	case models.DailyAverage:
+		return "average of the day's weigh-ins"
+	}
+	return "first weigh-in of the day"
+}
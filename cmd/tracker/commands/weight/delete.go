@@ -19,6 +19,8 @@ func newDeleteCmd(store storage.StorageManager) *cobra.Command {
 		RunE:  createDeleteCmdRunner(store),
 	}
 
+	addUnitFlag(cmd)
+
 	return cmd
 }
 
@@ -32,6 +34,11 @@ func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 			return result.ValidationFailed(err).Error
 		}
 
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
 		// Get record to show confirmation
 		record, err := store.GetWeightByID(recordID)
 		if err != nil {
@@ -45,7 +52,7 @@ func createDeleteCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 		confirmResult := display.ShowDeleteConfirmation(
 			record.ID,
 			record.Date.Format(validator.DateFormat),
-			fmt.Sprintf("%.1f", record.Weight),
+			unit.Format(record.Weight),
 			record.Notes,
 		)
 
@@ -2,8 +2,11 @@
 package weight
 
 import (
+	"fmt"
+
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/jack-sneddon/my-health-tracker/internal/validator"
 	"github.com/spf13/cobra"
@@ -17,6 +20,8 @@ func newGetCmd(store storage.StorageManager) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&flags.date, "date", "d", "", "Date to get weight record for (required)")
+	cmd.Flags().StringVar(&flags.policy, "policy", "", "Daily weight: first, min or average (default: your setting)")
+	addUnitFlag(cmd)
 	cmd.MarkFlagRequired("date")
 
 	return cmd
@@ -30,19 +35,41 @@ func createGetCmdRunner(store storage.StorageManager) func(*cobra.Command, []str
 			return result.ValidationFailed(err).Error
 		}
 
-		// 2. Get record from storage
-		record, err := store.GetWeight(date)
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		policy, err := dailyPolicy(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// 2. Get the day's weigh-ins from storage
+		records, err := store.GetWeightRange(date, date, false)
 		if err != nil {
 			return result.StorageError(err).Error
 		}
 
 		// 3. Handle not found
-		if record == nil {
+		if len(records) == 0 {
 			return result.NotFound("Weight record", flags.date).Error
 		}
 
 		// 4. Create success result and display
-		cmdResult := result.NewSuccess(*record, "Found weight record")
+		day := models.DailyWeights(records, policy)[0]
+		messages := []string{"Found weight record"}
+		if day.Entries > 1 {
+			messages = append(messages, fmt.Sprintf("%d weigh-ins on this date, showing the %s", day.Entries, policyName(policy)))
+		}
+		goal, err := goalMessage(store, day.Record, unit)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if goal != "" {
+			messages = append(messages, goal)
+		}
+		cmdResult := result.NewSuccess(models.WeightInUnit{Record: day.Record, Unit: unit}, messages...)
 		display.ShowCommandResult(cmdResult)
 
 		return nil
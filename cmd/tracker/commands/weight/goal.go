@@ -0,0 +1,237 @@
+// cmd/tracker/commands/weight/goal.go
+package weight
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/jack-sneddon/my-health-tracker/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+func newGoalCmd(store storage.StorageManager) *cobra.Command {
+	goalCmd := &cobra.Command{
+		Use:   "goal",
+		Short: "Set a goal weight and track progress toward it",
+		Long: `Set a goal weight to reach by a date, and project when you'll get there.
+
+Progress is judged on a straight line fitted to recent weigh-ins, so single
+days of water weight don't swing the projection.
+
+Examples:
+  # Aim for 175 lbs by June
+  tracker weight goal set 175 --by 2026-06-01
+
+  # Show the current and required rates and the projected date
+  tracker weight goal status
+  tracker weight goal status --days 14`,
+	}
+
+	goalCmd.AddCommand(newGoalSetCmd(store), newGoalStatusCmd(store))
+
+	return goalCmd
+}
+
+func newGoalSetCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set WEIGHT",
+		Short: "Set the goal weight and the date to reach it by",
+		Args:  cobra.ExactArgs(1),
+		RunE:  createGoalSetCmdRunner(store),
+	}
+
+	cmd.Flags().StringVar(&flags.by, "by", "", "Date to reach the goal by (required)")
+	addUnitFlag(cmd)
+	cmd.MarkFlagRequired("by")
+
+	return cmd
+}
+
+func newGoalStatusCmd(store storage.StorageManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show progress toward the goal weight",
+		RunE:  createGoalStatusCmdRunner(store),
+	}
+
+	cmd.Flags().IntVar(&flags.days, "days", models.DefaultGoalWindow, "Days of recent weigh-ins to project from")
+	addUnitFlag(cmd)
+
+	return cmd
+}
+
+func createGoalSetCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		value, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return result.ValidationFailed(fmt.Errorf("invalid goal weight: %s", args[0])).Error
+		}
+		target := unit.ToPounds(value)
+		if err := validateWeightRange(target, unit); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		by, err := validator.ParseDateAllowingFuture(flags.by)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// The latest weigh-in tells whether the goal is to lose or gain
+		latest, err := store.GetLastWeightRecord()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if latest == nil {
+			return result.ValidationFailed(fmt.Errorf("add a weigh-in before setting a goal")).Error
+		}
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		latestDay, err := recentWeights(store, latest.Date, 1, settings.GetDailyWeightPolicy())
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		goal := models.WeightGoal{
+			Target: target,
+			By:     by,
+			SetOn:  models.CalendarDate(time.Now()),
+			Start:  latestDay[len(latestDay)-1].Weight,
+		}
+		if err := goal.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.WeightGoal = &goal
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		weeks := goal.By.Sub(goal.SetOn).Hours() / 24 / 7
+		display.ShowCommandResult(result.NewSuccess(nil,
+			fmt.Sprintf("Goal set: %s by %s", unit.Format(goal.Target), goal.By.Format(validator.DateFormat)),
+			fmt.Sprintf("%s to go from %s, about %s per week",
+				unit.FormatChange(goal.Start-goal.Target), unit.Format(goal.Start),
+				unit.FormatChange((goal.Start-goal.Target)/weeks))))
+
+		return nil
+	}
+}
+
+func createGoalStatusCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		if flags.days < 7 || flags.days > 365 {
+			return result.ValidationFailed(fmt.Errorf("days must be between 7 and 365")).Error
+		}
+
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if settings.WeightGoal == nil {
+			return result.NewError(fmt.Errorf("No weight goal set (set one with 'tracker weight goal set')")).Error
+		}
+		goal := *settings.WeightGoal
+
+		latest, err := store.GetLastWeightRecord()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if latest == nil {
+			return result.NewError(fmt.Errorf("No weigh-ins found")).Error
+		}
+
+		records, err := recentWeights(store, latest.Date, flags.days, settings.GetDailyWeightPolicy())
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		status, ok := models.EvaluateWeightGoal(goal, records)
+		if !ok {
+			return result.NewError(fmt.Errorf("Need weigh-ins on at least two days in the last %d days to project progress", flags.days)).Error
+		}
+
+		display.ShowHeader("Weight Goal")
+		stats := map[string]string{
+			"Goal":           fmt.Sprintf("%s by %s", unit.Format(goal.Target), goal.By.Format(validator.DateFormat)),
+			"Current Weight": fmt.Sprintf("%s as of %s (fitted to %d days)", unit.Format(status.Current), status.AsOf.Format(validator.DateFormat), len(records)),
+			"Current Rate":   unit.FormatChange(status.Rate) + " per week",
+		}
+
+		switch {
+		case status.Reached:
+			stats["Status"] = "Goal reached"
+		default:
+			stats["Remaining"] = unit.FormatChange(status.Remaining)
+			stats["Required Rate"] = "-"
+			if status.RequiredRate != 0 {
+				stats["Required Rate"] = unit.FormatChange(status.RequiredRate) + " per week"
+			}
+			stats["Projected Date"] = formatArrival(status.Projected)
+			stats["Likely Range"] = fmt.Sprintf("%s to %s", formatArrival(status.Earliest), formatArrival(status.Latest))
+			stats["Status"] = "Behind schedule"
+			if status.OnTrack {
+				stats["Status"] = "On track"
+			}
+		}
+		display.ShowStats(stats)
+
+		return nil
+	}
+}
+
+// recentWeights returns the daily weights in the days up to and including a date
+func recentWeights(store storage.StorageManager, through time.Time, days int, policy models.DailyWeightPolicy) ([]models.WeightRecord, error) {
+	records, err := store.GetWeightRange(through.AddDate(0, 0, -(days-1)), through, false)
+	if err != nil {
+		return nil, err
+	}
+	return models.DailyRecords(records, policy), nil
+}
+
+// formatArrival shows a projected date, or that the goal isn't being approached
+func formatArrival(date *time.Time) string {
+	if date == nil {
+		return "not at this rate"
+	}
+	return date.Format(validator.DateFormat)
+}
+
+// goalMessage describes whether the trend up to a saved weigh-in is on track
+// for the current goal. It is empty without a goal or enough recent weigh-ins.
+func goalMessage(store storage.StorageManager, record models.WeightRecord, unit models.WeightUnit) (string, error) {
+	settings, err := store.GetSettings()
+	if err != nil || settings.WeightGoal == nil {
+		return "", err
+	}
+	goal := *settings.WeightGoal
+
+	records, err := recentWeights(store, record.Day(), models.DefaultGoalWindow, settings.GetDailyWeightPolicy())
+	if err != nil {
+		return "", err
+	}
+	status, ok := models.EvaluateWeightGoal(goal, records)
+	if !ok {
+		return "", nil
+	}
+
+	target := fmt.Sprintf("%s by %s", unit.Format(goal.Target), goal.By.Format(validator.DateFormat))
+	if status.OnTrack {
+		return "On track for " + target, nil
+	}
+	return "Behind schedule for " + target, nil
+}
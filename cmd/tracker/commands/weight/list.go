@@ -32,6 +32,10 @@ func newListCmd(store storage.StorageManager) *cobra.Command {
 	cmd.Flags().StringVarP(&flags.toDate, "to", "t", "", "End date for listing weights")
 	cmd.Flags().BoolVarP(&flags.lastWeek, "week", "w", false, "Show last 7 days")
 	cmd.Flags().BoolVarP(&flags.lastMonth, "month", "m", false, "Show last month")
+	cmd.Flags().Float64Var(&flags.smoothing, "smoothing", 0, "Trend smoothing factor from 0 to 1 (default: your setting)")
+	cmd.Flags().BoolVar(&flags.daily, "daily", false, "Show one weight per day instead of every weigh-in")
+	cmd.Flags().StringVar(&flags.policy, "policy", "", "Daily weight: first, min or average (default: your setting)")
+	addUnitFlag(cmd)
 
 	return cmd
 }
@@ -63,6 +67,11 @@ func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []st
 			isDefaultRange = false
 		}
 
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
 		// Get records
 		records, err := store.GetWeightRange(fromDate, toDate, isDefaultRange)
 		if err != nil {
@@ -75,6 +84,16 @@ func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []st
 				toDate.Format(validator.DateFormat))).Error
 		}
 
+		policy, err := dailyPolicy(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+		models.SortWeighIns(records)
+		days := models.DailyWeights(records, policy)
+		if flags.daily {
+			records = models.DailyRecords(records, policy)
+		}
+
 		// Calculate statistics
 		var totalWeight float64
 		minWeight := records[0].Weight
@@ -91,8 +110,9 @@ func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []st
 			}
 		}
 
-		if len(records) > 1 {
-			change = records[len(records)-1].Weight - records[0].Weight
+		// Compare days rather than weigh-ins, so an evening weight doesn't end the range
+		if len(days) > 1 {
+			change = days[len(days)-1].Record.Weight - days[0].Record.Weight
 		}
 
 		// Display results through display package
@@ -100,20 +120,108 @@ func createListCmdRunner(store storage.StorageManager) func(*cobra.Command, []st
 			fromDate.Format(validator.DateFormat),
 			toDate.Format(validator.DateFormat)))
 
-		display.ShowWeightList(records)
+		history, smoothing, err := trendHistory(cmd, store)
+		if err != nil {
+			return err
+		}
+		// Trends follow one weight per day, so extra weigh-ins don't count as extra days
+		history = models.DailyRecords(history, policy)
+		trend, points := weightTrend(records, history, smoothing)
+		if flags.daily {
+			display.ShowDailyWeightList(days, trend, unit)
+		} else {
+			display.ShowWeightList(records, trend, unit)
+		}
 
-		display.ShowStats(map[string]string{
+		// Fat and lean mass trends tell fat loss from muscle loss
+		composition, compositionPoints := compositionTrend(records, history, smoothing)
+		if hasComposition(records) {
+			display.ShowBodyCompositionList(records, composition, unit)
+		}
+
+		stats := map[string]string{
 			"Total Records":  fmt.Sprintf("%d", len(records)),
-			"Average Weight": fmt.Sprintf("%.1f lbs", totalWeight/float64(len(records))),
-			"Weight Range": fmt.Sprintf("%.1f - %.1f lbs (%.1f lbs)",
-				minWeight, maxWeight, maxWeight-minWeight),
-			"Overall Change": fmt.Sprintf("%.1f lbs", change),
-		})
+			"Average Weight": unit.Format(totalWeight / float64(len(records))),
+			"Weight Range": fmt.Sprintf("%s - %s (%s)",
+				unit.Format(minWeight), unit.Format(maxWeight), unit.FormatChange(maxWeight-minWeight)),
+			"Overall Change": unit.FormatChange(change),
+		}
+		if len(days) != len(records) {
+			stats["Days"] = fmt.Sprintf("%d (daily weight is the %s)", len(days), policyName(policy))
+		}
+		// The trend evens out day-to-day swings from water and salt
+		if rate, ok := models.WeeklyTrendRate(points); ok {
+			stats["Trend Change"] = unit.FormatChange(points[len(points)-1].Trend - points[0].Trend)
+			stats["Trend Rate"] = unit.FormatChange(rate) + " per week"
+		}
+		if len(compositionPoints) > 1 {
+			first, last := compositionPoints[0], compositionPoints[len(compositionPoints)-1]
+			stats["Fat Mass Trend"] = unit.FormatChange(last.FatMass.Trend - first.FatMass.Trend)
+			stats["Lean Mass Trend"] = unit.FormatChange(last.LeanMass.Trend - first.LeanMass.Trend)
+		}
+		display.ShowStats(stats)
 
 		return nil
 	}
 }
 
+// hasComposition reports whether any record has body composition measured
+func hasComposition(records []models.WeightRecord) bool {
+	for _, record := range records {
+		if record.HasComposition() {
+			return true
+		}
+	}
+	return false
+}
+
+// trendHistory returns every weigh-in up to now and the smoothing factor, so
+// trends are settled by the start of the listed range
+func trendHistory(cmd *cobra.Command, store storage.StorageManager) ([]models.WeightRecord, float64, error) {
+	smoothing := flags.smoothing
+	if !cmd.Flags().Changed("smoothing") {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return nil, 0, result.StorageError(err).Error
+		}
+		smoothing = settings.GetTrendSmoothing()
+	}
+	if err := models.ValidateTrendSmoothing(smoothing); err != nil {
+		return nil, 0, result.ValidationFailed(err).Error
+	}
+
+	history, err := store.GetWeightRange(time.Time{}, time.Now(), false)
+	if err != nil {
+		return nil, 0, result.StorageError(err).Error
+	}
+	return history, smoothing, nil
+}
+
+// weightTrend returns the trend on each listed record's day, and the trend
+// points of the listed days in date order. history holds one record per day.
+func weightTrend(records, history []models.WeightRecord, smoothing float64) ([]float64, []models.TrendPoint) {
+	listed := make(map[time.Time]bool, len(records))
+	for _, record := range records {
+		listed[record.Day()] = true
+	}
+
+	byDay := make(map[time.Time]float64, len(history))
+	var points []models.TrendPoint
+	for _, point := range models.ComputeWeightTrend(history, smoothing) {
+		day := time.Date(point.Date.Year(), point.Date.Month(), point.Date.Day(), 0, 0, 0, 0, time.UTC)
+		byDay[day] = point.Trend
+		if listed[day] {
+			points = append(points, point)
+		}
+	}
+
+	trend := make([]float64, len(records))
+	for i, record := range records {
+		trend[i] = byDay[record.Day()]
+	}
+	return trend, points
+}
+
 func calculateWeightStats(records []models.WeightRecord) weightStats {
 	stats := weightStats{
 		TotalRecords: len(records),
@@ -140,7 +248,7 @@ func calculateWeightStats(records []models.WeightRecord) weightStats {
 	return stats
 }
 
-func displayWeightList(records []models.WeightRecord, stats weightStats, fromDate, toDate time.Time) {
+func displayWeightList(records []models.WeightRecord, stats weightStats, fromDate, toDate time.Time, unit models.WeightUnit) {
 	display.ShowHeader(fmt.Sprintf("Weight Records from %s to %s",
 		fromDate.Format(validator.DateFormat),
 		toDate.Format(validator.DateFormat)))
@@ -152,7 +260,7 @@ func displayWeightList(records []models.WeightRecord, stats weightStats, fromDat
 				rows = append(rows, []string{
 					r.ID,
 					r.Date.Format(validator.DateFormat),
-					fmt.Sprintf("%.1f", r.Weight),
+					unit.Format(r.Weight),
 					r.Notes,
 				})
 			}
@@ -161,9 +269,9 @@ func displayWeightList(records []models.WeightRecord, stats weightStats, fromDat
 
 	display.ShowStats(map[string]string{
 		"Total Records":  fmt.Sprintf("%d", stats.TotalRecords),
-		"Average Weight": fmt.Sprintf("%.1f lbs", stats.AverageWeight),
-		"Weight Range": fmt.Sprintf("%.1f - %.1f lbs (%.1f lbs)",
-			stats.MinWeight, stats.MaxWeight, stats.MaxWeight-stats.MinWeight),
-		"Overall Change": fmt.Sprintf("%.1f lbs", stats.TotalChange),
+		"Average Weight": unit.Format(stats.AverageWeight),
+		"Weight Range": fmt.Sprintf("%s - %s (%s)",
+			unit.Format(stats.MinWeight), unit.Format(stats.MaxWeight), unit.FormatChange(stats.MaxWeight-stats.MinWeight)),
+		"Overall Change": unit.FormatChange(stats.TotalChange),
 	})
 }
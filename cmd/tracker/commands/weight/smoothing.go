@@ -0,0 +1,58 @@
+// cmd/tracker/commands/weight/smoothing.go
+package weight
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newSmoothingCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "smoothing [FACTOR]",
+		Short: "Show or set the weight trend smoothing factor",
+		Long: `Show or set how quickly the weight trend follows the scale.
+
+Each weigh-in moves the trend by this share of its difference from the trend.
+The default of 0.1 evens out swings from water and salt; larger values react
+faster to real change but smooth less.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: createSmoothingCmdRunner(store),
+	}
+}
+
+func createSmoothingCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(args) == 0 {
+			display.ShowInfo("Trend smoothing factor is %.2f", settings.GetTrendSmoothing())
+			return nil
+		}
+
+		smoothing, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return result.ValidationFailed(fmt.Errorf("invalid smoothing factor: %s", args[0])).Error
+		}
+		if err := models.ValidateTrendSmoothing(smoothing); err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		settings.TrendSmoothing = smoothing
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil, fmt.Sprintf("Trend smoothing factor set to %.2f", smoothing)))
+
+		return nil
+	}
+}
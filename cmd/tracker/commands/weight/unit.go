@@ -0,0 +1,50 @@
+// cmd/tracker/commands/weight/unit.go
+package weight
+
+import (
+	"fmt"
+
+	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
+	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func newUnitCmd(store storage.StorageManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unit [lb|kg|st]",
+		Short: "Show or set the preferred weight unit",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  createUnitCmdRunner(store),
+	}
+}
+
+func createUnitCmdRunner(store storage.StorageManager) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, err := store.GetSettings()
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+
+		if len(args) == 0 {
+			display.ShowInfo("Weights are shown in %s", settings.GetWeightUnit())
+			return nil
+		}
+
+		unit, err := models.ParseWeightUnit(args[0])
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
+		// Records stay in pounds, so changing the unit only changes how they are shown
+		settings.WeightUnit = unit
+		if err := store.SaveSettings(settings); err != nil {
+			return result.StorageError(err).Error
+		}
+
+		display.ShowCommandResult(result.NewSuccess(nil, fmt.Sprintf("Weights will be shown in %s", unit)))
+
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
 	"github.com/jack-sneddon/my-health-tracker/internal/display"
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -19,8 +20,10 @@ func newUpdateCmd(store storage.StorageManager) *cobra.Command {
 		RunE:  createUpdateCmdRunner(store),
 	}
 
-	cmd.Flags().Float64VarP(&flags.value, "value", "v", 0, "New weight value in pounds")
+	cmd.Flags().Float64VarP(&flags.value, "value", "v", 0, "New weight value in the chosen unit")
 	cmd.Flags().StringVarP(&flags.notes, "notes", "n", "", "Updated notes about the weight record")
+	addUnitFlag(cmd)
+	addCompositionFlags(cmd)
 
 	return cmd
 }
@@ -29,6 +32,11 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 	return func(cmd *cobra.Command, args []string) error {
 		recordID := args[0]
 
+		unit, err := weightUnit(cmd, store)
+		if err != nil {
+			return result.ValidationFailed(err).Error
+		}
+
 		// Get existing record
 		record, err := store.GetWeightByID(recordID)
 		if err != nil {
@@ -44,15 +52,16 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 		// Update fields if provided
 		if cmd.Flags().Changed("value") {
 			// Validate weight range first
-			if err := validateWeightRange(flags.value); err != nil {
+			weight := unit.ToPounds(flags.value)
+			if err := validateWeightRange(weight, unit); err != nil {
 				return result.ValidationFailed(err).Error
 			}
-			record.Weight = flags.value
+			record.Weight = weight
 
 			// Then check for significant change
 			change := math.Abs(record.Weight - originalWeight)
 			if change > MaxWeightChange {
-				display.ShowWarning(fmt.Sprintf("Weight change of %.1f lbs seems unusual", change))
+				display.ShowWarning("Weight change of %s seems unusual", unit.FormatChange(change))
 				if !display.ConfirmAction("Do you want to continue?").Confirmed {
 					display.ShowInfo("Operation cancelled")
 					return result.NewError(fmt.Errorf("operation cancelled")).Error
@@ -62,13 +71,26 @@ func createUpdateCmdRunner(store storage.StorageManager) func(*cobra.Command, []
 		if cmd.Flags().Changed("notes") {
 			record.Notes = flags.notes
 		}
+		applyComposition(cmd, record, unit)
+		if err := record.Validate(); err != nil {
+			return result.ValidationFailed(err).Error
+		}
 
 		// Perform update
 		if err := store.UpdateWeight(recordID, *record); err != nil {
 			return result.StorageError(err).Error
 		}
 
-		cmdResult := result.NewSuccess(*record, "Weight record updated successfully")
+		messages := []string{"Weight record updated successfully"}
+		goal, err := goalMessage(store, *record, unit)
+		if err != nil {
+			return result.StorageError(err).Error
+		}
+		if goal != "" {
+			messages = append(messages, goal)
+		}
+
+		cmdResult := result.NewSuccess(models.WeightInUnit{Record: *record, Unit: unit}, messages...)
 		display.ShowCommandResult(cmdResult)
 
 		return nil
@@ -2,6 +2,7 @@
 package weight
 
 import (
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
 	"github.com/jack-sneddon/my-health-tracker/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +17,18 @@ type weightFlags struct {
 	toDate    string
 	lastWeek  bool
 	lastMonth bool
+	unit      string
+	smoothing float64
+	by        string
+	days      int
+	at        string
+	policy    string
+	daily     bool
+
+	bodyFat     float64
+	muscleMass  float64
+	water       float64
+	visceralFat float64
 }
 
 var flags weightFlags
@@ -31,6 +44,27 @@ Examples:
   # Add a weight record
   tracker weight add --value 185.5 --date 2024-01-08 --notes "Morning weight"
 
+  # Log morning and evening weigh-ins, and list one weight per day
+  tracker weight add --value 185.5 --at 07:00
+  tracker weight add --value 187.0 --at 21:30
+  tracker weight daily min
+  tracker weight list --daily
+
+  # Record body composition from a smart scale
+  tracker weight add --value 185.5 --body-fat 22.4 --muscle 136 --water 55.1 --visceral-fat 9
+
+  # Enter or show a weight in another unit, or make it the default
+  tracker weight add --value 84.1 --unit kg
+  tracker weight list --unit st
+  tracker weight unit kg
+
+  # Follow the scale more closely in the trend column
+  tracker weight smoothing 0.2
+
+  # Set a goal weight and check the projected arrival date
+  tracker weight goal set 175 --by 2026-06-01
+  tracker weight goal status
+
   # Get weight for a specific date
   tracker weight get --date 2024-01-08
 
@@ -51,7 +85,28 @@ Examples:
 		newListCmd(store),
 		newUpdateCmd(store),
 		newDeleteCmd(store),
+		newUnitCmd(store),
+		newSmoothingCmd(store),
+		newGoalCmd(store),
+		newDailyCmd(store),
 	)
 
 	return weightCmd
 }
+
+// addUnitFlag adds --unit to a weight command
+func addUnitFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&flags.unit, "unit", "u", "", "Weight unit: lb, kg or st (default: your preference)")
+}
+
+// weightUnit returns the unit given with --unit, or the preferred unit
+func weightUnit(cmd *cobra.Command, store storage.StorageManager) (models.WeightUnit, error) {
+	if cmd.Flags().Changed("unit") {
+		return models.ParseWeightUnit(flags.unit)
+	}
+	settings, err := store.GetSettings()
+	if err != nil {
+		return "", err
+	}
+	return settings.GetWeightUnit(), nil
+}
@@ -0,0 +1,142 @@
+// internal/calories/calories.go
+package calories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+	"github.com/jack-sneddon/my-health-tracker/internal/storage"
+)
+
+// activityMETs holds metabolic equivalents for the default activities at
+// light, moderate and vigorous effort, from the Compendium of Physical Activities
+var activityMETs = map[models.ActivityType]models.METs{
+	models.Jogging:       {Light: 6.0, Moderate: 8.3, Vigorous: 11.0},
+	models.Walking:       {Light: 2.8, Moderate: 3.5, Vigorous: 5.0},
+	models.Cycling:       {Light: 4.0, Moderate: 6.8, Vigorous: 10.0},
+	models.MountainBike:  {Light: 6.0, Moderate: 8.5, Vigorous: 14.0},
+	models.Skiing:        {Light: 4.3, Moderate: 5.3, Vigorous: 8.0},
+	models.Pickleball:    {Light: 3.0, Moderate: 4.1, Vigorous: 6.0},
+	models.WeightLifting: {Light: 3.5, Moderate: 5.0, Vigorous: 6.0},
+}
+
+// categoryMETs is used for catalog activities without their own values
+var categoryMETs = map[models.ActivityCategory]models.METs{
+	models.CategoryCardio:   {Light: 4.0, Moderate: 6.0, Vigorous: 9.0},
+	models.CategoryStrength: {Light: 3.5, Moderate: 5.0, Vigorous: 6.0},
+	models.CategorySport:    {Light: 4.0, Moderate: 6.0, Vigorous: 8.0},
+}
+
+// METFor returns the MET value for an activity at an intensity. Values set
+// on the catalog entry win over the built-in table, which wins over the
+// category default.
+func METFor(catalog models.ActivityCatalog, name models.ActivityType, intensity models.Intensity) float64 {
+	mets := categoryMETs[models.CategoryCardio]
+	if activity := catalog.Find(string(name)); activity != nil {
+		switch {
+		case activity.METs != nil:
+			mets = *activity.METs
+		case hasBuiltIn(activity.Name):
+			mets = activityMETs[activity.Name]
+		default:
+			if byCategory, ok := categoryMETs[activity.Category]; ok {
+				mets = byCategory
+			}
+		}
+	} else if hasBuiltIn(name) {
+		mets = activityMETs[name]
+	}
+	return mets.For(intensity)
+}
+
+func hasBuiltIn(name models.ActivityType) bool {
+	_, ok := activityMETs[name]
+	return ok
+}
+
+// Burned returns kilocalories for minutes at a MET value for a body weight
+// in pounds, using the standard MET x 3.5 x kg / 200 per minute formula
+func Burned(met, weightLbs float64, minutes int) float64 {
+	return met * 3.5 * models.Kilograms.FromPounds(weightLbs) / 200 * float64(minutes)
+}
+
+// Estimate is the calorie estimate for one exercise session
+type Estimate struct {
+	Calories   float64
+	MET        float64
+	Intensity  models.Intensity
+	Weight     float64           // pounds used for the estimate
+	WeightDate time.Time         // date of the weigh-in used
+	Unit       models.WeightUnit // unit the weight is shown in
+}
+
+func (e Estimate) String() string {
+	return fmt.Sprintf("Estimated %.0f calories burned (%s, MET %.1f at %s from %s)",
+		e.Calories, e.Intensity, e.MET, e.Unit.Format(e.Weight), e.WeightDate.Format("2006-01-02"))
+}
+
+// Estimator works out calories for exercise sessions using the activity
+// catalog and the weigh-in closest to each session
+type Estimator struct {
+	store   storage.StorageManager
+	catalog models.ActivityCatalog
+	unit    models.WeightUnit
+	weights map[time.Time]*models.WeightRecord
+}
+
+// NewEstimator loads the activity catalog and weight unit from settings
+func NewEstimator(store storage.StorageManager) (*Estimator, error) {
+	settings, err := store.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return &Estimator{
+		store:   store,
+		catalog: settings.GetActivityCatalog(),
+		unit:    settings.GetWeightUnit(),
+		weights: make(map[time.Time]*models.WeightRecord),
+	}, nil
+}
+
+// Estimate returns the calorie estimate for a session, or nil when no weight
+// has been recorded yet
+func (e *Estimator) Estimate(record models.ExerciseRecord) (*Estimate, error) {
+	weight, err := e.weightFor(record.Date)
+	if err != nil || weight == nil {
+		return nil, err
+	}
+
+	intensity := record.IntensityLevel()
+	met := METFor(e.catalog, record.Activity, intensity)
+	return &Estimate{
+		Calories:   Burned(met, weight.Weight, record.Duration),
+		MET:        met,
+		Intensity:  intensity,
+		Weight:     weight.Weight,
+		WeightDate: weight.Date,
+		Unit:       e.unit,
+	}, nil
+}
+
+// weightFor finds the latest weigh-in on or before date, falling back to the
+// first one after it for sessions logged before any weigh-in
+func (e *Estimator) weightFor(date time.Time) (*models.WeightRecord, error) {
+	if weight, ok := e.weights[date]; ok {
+		return weight, nil
+	}
+
+	weight, err := e.store.GetPreviousWeightRecord(date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	if weight == nil {
+		weight, err = e.store.GetNextWeightRecord(date)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	e.weights[date] = weight
+	return weight, nil
+}
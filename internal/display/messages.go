@@ -3,7 +3,9 @@ package display
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/jack-sneddon/my-health-tracker/cmd/tracker/commands/result"
@@ -64,7 +66,7 @@ func ShowWeightRecord(id string, date, weight string, notes string) {
 	headerColor.Println("\nWeight Record:")
 	fmt.Printf("  ID:     %s\n", id)
 	fmt.Printf("  Date:   %s\n", date)
-	fmt.Printf("  Weight: %s lbs\n", weight)
+	fmt.Printf("  Weight: %s\n", weight)
 	if notes != "" {
 		fmt.Printf("  Notes:  %s\n", notes)
 	}
@@ -75,7 +77,7 @@ func ShowDeleteConfirmation(id, date, weight, notes string) ConfirmationResult {
 	headerColor.Println("\nDelete Confirmation:")
 	fmt.Printf("  ID:     %s\n", id)
 	fmt.Printf("  Date:   %s\n", date)
-	fmt.Printf("  Weight: %s lbs\n", weight)
+	fmt.Printf("  Weight: %s\n", weight)
 	if notes != "" {
 		fmt.Printf("  Notes:  %s\n", notes)
 	}
@@ -99,27 +101,68 @@ func ShowCommandResult(result result.CommandResult) {
 		ShowWeightRecord(
 			weightRecord.ID,
 			weightRecord.Date.Format(validator.DateFormat),
-			fmt.Sprintf("%.1f", weightRecord.Weight),
+			models.Pounds.Format(weightRecord.Weight),
 			weightRecord.Notes,
 		)
-	}
-
-	if weightRecord, ok := result.Data.(models.WeightRecord); ok {
+	} else if weight, ok := result.Data.(models.WeightInUnit); ok {
 		ShowWeightRecord(
-			weightRecord.ID,
-			weightRecord.Date.Format(validator.DateFormat),
-			fmt.Sprintf("%.1f", weightRecord.Weight),
-			weightRecord.Notes,
+			weight.Record.ID,
+			weight.Record.FormatWhen(),
+			weight.Unit.Format(weight.Record.Weight),
+			weight.Record.Notes,
 		)
+		ShowBodyComposition(weight.Record, weight.Unit)
 	} else if exerciseRecord, ok := result.Data.(models.ExerciseRecord); ok {
 		ShowExerciseRecord(
+			exerciseRecord.ID,
 			exerciseRecord.Date.Format(validator.DateFormat),
 			string(exerciseRecord.Activity),
 			exerciseRecord.OtherActivity,
 			exerciseRecord.Duration,
+			formatIntensity(exerciseRecord),
+			formatDistance(exerciseRecord),
+			formatPaceAndSpeed(exerciseRecord),
 			exerciseRecord.Notes,
 			exerciseRecord.Completed,
 		)
+		if exerciseRecord.Plan != nil {
+			ShowExercisePlan(exerciseRecord)
+		}
+		if len(exerciseRecord.Lifts) > 0 {
+			ShowLifts(exerciseRecord.Lifts, models.Epley)
+		}
+	} else if fastingRecord, ok := result.Data.(models.FastingRecord); ok {
+		ShowFastingRecord(
+			fastingRecord.Date.Format(validator.DateFormat),
+			string(fastingRecord.ExpectedPattern),
+			string(fastingRecord.ActualPattern),
+			string(fastingRecord.Source),
+			fastingRecord.EatingWindow,
+			fastingRecord.Notes,
+			fastingRecord.IsCompliant(),
+		)
+	} else if fastingWindow, ok := result.Data.(models.FastingWindow); ok {
+		ShowFastingWindow(fastingWindow, time.Now())
+	} else if mealEntry, ok := result.Data.(models.MealEntry); ok {
+		ShowMealEntry(mealEntry)
+	} else if soda, ok := result.Data.(models.SodaWithAllowance); ok {
+		sodaRecord := soda.Record
+		ShowSodaRecord(
+			sodaRecord.Date.Format(validator.DateFormat),
+			sodaRecord.Consumed,
+			sodaRecord.Quantity,
+			soda.Allowance.DailyCap(sodaRecord.Date.Weekday()),
+			sodaRecord.Caffeine,
+			sodaRecord.Sugar,
+			sodaRecord.Notes,
+			sodaRecord.IsCompliant(soda.Allowance),
+		)
+	} else if activity, ok := result.Data.(models.Activity); ok {
+		ShowActivity(activity)
+	} else if sodaEntry, ok := result.Data.(models.SodaEntry); ok {
+		ShowSodaEntry(sodaEntry)
+	} else if gearUsage, ok := result.Data.(models.GearUsage); ok {
+		ShowGear(gearUsage)
 	}
 }
 
@@ -180,23 +223,106 @@ func ShowHeader(text string) {
 }
 
 // internal/display/messages.go
-func ShowWeightList(records []models.WeightRecord) {
-	fmt.Printf("%-8s  %-10s  %-7s  %s\n", "ID", "Date", "Weight", "Notes")
-	fmt.Println(strings.Repeat("-", 60))
+// ShowWeightList displays weigh-ins with the smoothed trend weight on each date
+func ShowWeightList(records []models.WeightRecord, trend []float64, unit models.WeightUnit) {
+	fmt.Printf("%-8s  %-10s  %-5s  %-13s  %-13s  %s\n", "ID", "Date", "Time", "Weight", "Trend", "Notes")
+	fmt.Println(strings.Repeat("-", 82))
 
-	for _, record := range records {
-		fmt.Printf("%-8s  %-10s  %7.1f  %s\n",
+	for i, record := range records {
+		clock := "-"
+		if record.Time != nil {
+			clock = record.Time.Format(validator.TimeFormat)
+		}
+		fmt.Printf("%-8s  %-10s  %-5s  %13s  %13s  %s\n",
 			record.ID,
 			record.Date.Format(validator.DateFormat),
-			record.Weight,
+			clock,
+			unit.Format(record.Weight),
+			unit.Format(trend[i]),
 			record.Notes)
 	}
 	fmt.Println()
 }
 
+// ShowDailyWeightList shows one weight per day with the number of weigh-ins
+// it was chosen from
+func ShowDailyWeightList(days []models.DailyWeight, trend []float64, unit models.WeightUnit) {
+	fmt.Printf("%-10s  %-13s  %-13s  %-9s  %s\n", "Date", "Weight", "Trend", "Weigh-ins", "Notes")
+	fmt.Println(strings.Repeat("-", 75))
+
+	for i, day := range days {
+		fmt.Printf("%-10s  %13s  %13s  %9d  %s\n",
+			day.Record.Date.Format(validator.DateFormat),
+			unit.Format(day.Record.Weight),
+			unit.Format(trend[i]),
+			day.Entries,
+			day.Record.Notes)
+	}
+	fmt.Println()
+}
+
+// ShowBodyComposition displays the smart scale readings of a weight record
+// with the fat and lean mass derived from them
+func ShowBodyComposition(record models.WeightRecord, unit models.WeightUnit) {
+	if !record.HasComposition() {
+		return
+	}
+	headerColor.Println("\nBody Composition:")
+	if fat, ok := record.FatMass(); ok {
+		lean, _ := record.LeanMass()
+		fmt.Printf("  Body Fat:     %.1f%%\n", record.BodyFat)
+		fmt.Printf("  Fat Mass:     %s\n", unit.Format(fat))
+		fmt.Printf("  Lean Mass:    %s\n", unit.Format(lean))
+	}
+	if record.MuscleMass != 0 {
+		fmt.Printf("  Muscle Mass:  %s\n", unit.Format(record.MuscleMass))
+	}
+	if record.Water != 0 {
+		fmt.Printf("  Water:        %.1f%%\n", record.Water)
+	}
+	if record.VisceralFat != 0 {
+		fmt.Printf("  Visceral Fat: %.0f\n", record.VisceralFat)
+	}
+}
+
+// ShowBodyCompositionList shows the weigh-ins with body composition measured.
+// trend holds the smoothed fat and lean mass for each record, nil when body
+// fat wasn't measured.
+func ShowBodyCompositionList(records []models.WeightRecord, trend []*models.CompositionTrend, unit models.WeightUnit) {
+	var rows [][]string
+	for i, record := range records {
+		if !record.HasComposition() {
+			continue
+		}
+		row := []string{record.ID, record.Date.Format(validator.DateFormat), "-", "-", "-", "-", "-", "-", "-", "-"}
+		if fat, ok := record.FatMass(); ok {
+			lean, _ := record.LeanMass()
+			row[2] = fmt.Sprintf("%.1f%%", record.BodyFat)
+			row[3] = unit.Format(fat)
+			row[5] = unit.Format(lean)
+		}
+		if trend[i] != nil {
+			row[4] = unit.Format(trend[i].FatMass.Trend)
+			row[6] = unit.Format(trend[i].LeanMass.Trend)
+		}
+		if record.MuscleMass != 0 {
+			row[7] = unit.Format(record.MuscleMass)
+		}
+		if record.Water != 0 {
+			row[8] = fmt.Sprintf("%.1f%%", record.Water)
+		}
+		if record.VisceralFat != 0 {
+			row[9] = fmt.Sprintf("%.0f", record.VisceralFat)
+		}
+		rows = append(rows, row)
+	}
+	ShowTable([]string{"ID", "Date", "Body Fat", "Fat Mass", "Fat Trend", "Lean Mass", "Lean Trend", "Muscle", "Water", "Visceral"}, rows)
+}
+
 // ShowExerciseRecord displays a formatted exercise record
-func ShowExerciseRecord(date string, activity string, otherActivity string, duration int, notes string, completed bool) {
+func ShowExerciseRecord(id string, date string, activity string, otherActivity string, duration int, intensity string, distance string, pace string, notes string, completed bool) {
 	headerColor.Println("\nExercise Record:")
+	fmt.Printf("  ID:         %s\n", id)
 	fmt.Printf("  Date:       %s\n", date)
 	if activity == "other" {
 		fmt.Printf("  Activity:   %s (%s)\n", activity, otherActivity)
@@ -204,38 +330,141 @@ func ShowExerciseRecord(date string, activity string, otherActivity string, dura
 		fmt.Printf("  Activity:   %s\n", activity)
 	}
 	fmt.Printf("  Duration:   %d minutes\n", duration)
+	if intensity != "-" {
+		fmt.Printf("  Intensity:  %s\n", intensity)
+	}
+	if distance != "-" {
+		fmt.Printf("  Distance:   %s\n", distance)
+		fmt.Printf("  Pace:       %s\n", pace)
+	}
 	if notes != "" {
 		fmt.Printf("  Notes:      %s\n", notes)
 	}
 	fmt.Printf("  Completed:  %v\n", completed)
 }
 
-func ShowExerciseList(records []models.ExerciseRecord) {
-	headerColor.Printf("\n%-12s %-15s %-8s %-30s %s\n",
+// ShowLifts displays the lifts of a strength session with volume and estimated 1RM
+func ShowLifts(lifts []models.Lift, formula models.OneRepMaxFormula) {
+	rows := make([][]string, len(lifts))
+	for i, lift := range lifts {
+		oneRepMax := "-"
+		if estimate := lift.OneRepMax(formula); estimate > 0 {
+			oneRepMax = fmt.Sprintf("%.1f", estimate)
+		}
+		rows[i] = []string{
+			lift.Name,
+			lift.FormatSets(),
+			fmt.Sprintf("%d", lift.Reps()),
+			fmt.Sprintf("%.0f", lift.Volume()),
+			oneRepMax,
+		}
+	}
+	ShowTable([]string{"Lift", "Sets", "Reps", "Volume (lbs)", "Est. 1RM"}, rows)
+}
+
+// ShowExerciseList shows sessions with their calorie estimates, and distances
+// in the given unit; a nil estimate means no weight was available
+func ShowExerciseList(records []models.ExerciseRecord, calories []*float64, unit models.DistanceUnit) {
+	headerColor.Printf("\n%-8s %-12s %-15s %-8s %-9s %-8s %-9s %-10s %-20s %s\n",
+		"ID",
 		"Date",
 		"Activity",
 		"Duration",
+		"Intensity",
+		"Calories",
+		"Distance",
+		"Pace",
 		"Notes",
 		"Completed")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Println(strings.Repeat("-", 119))
 
-	for _, record := range records {
+	for i, record := range records {
 		// Format activity string
 		activityStr := string(record.Activity)
 		if record.Activity == models.Other {
 			activityStr = fmt.Sprintf("%s (%s)", record.Activity, record.OtherActivity)
 		}
 
-		fmt.Printf("%-12s %-15s %-8d %-30s %v\n",
+		caloriesStr := "-"
+		if i < len(calories) && calories[i] != nil {
+			caloriesStr = fmt.Sprintf("%.0f", *calories[i])
+		}
+
+		distanceStr := "-"
+		if record.Distance > 0 {
+			distanceStr = unit.FormatDistance(record.Distance)
+		}
+
+		fmt.Printf("%-8s %-12s %-15s %-8d %-9s %-8s %-9s %-10s %-20s %v\n",
+			record.ID,
 			record.Date.Format(validator.DateFormat),
 			activityStr,
 			record.Duration,
-			truncateString(record.Notes, 30),
-			record.Completed)
+			formatIntensity(record),
+			caloriesStr,
+			distanceStr,
+			unit.FormatPace(record.Pace()),
+			truncateString(record.Notes, 20),
+			formatCompleted(record))
 	}
 	fmt.Println()
 }
 
+// formatCompleted shows whether a session was done, or for planned sessions
+// not yet done whether they are still to come or were missed
+func formatCompleted(record models.ExerciseRecord) string {
+	switch {
+	case record.IsPending(time.Now()):
+		return "planned"
+	case record.IsMissed(time.Now()):
+		return "missed"
+	}
+	return fmt.Sprintf("%v", record.Completed)
+}
+
+// ShowExercisePlan displays what was planned for a session and how it went
+func ShowExercisePlan(record models.ExerciseRecord) {
+	planned := fmt.Sprintf("%d minutes", record.Plan.Duration)
+	if record.Plan.Distance > 0 {
+		planned = fmt.Sprintf("%s, %.2f mi", planned, record.Plan.Distance)
+	}
+	status := formatCompleted(record)
+	if record.Completed {
+		status = "done"
+	}
+	fmt.Printf("  Planned:    %s (%s)\n", planned, status)
+}
+
+// formatDistance shows distance and any elevation gain
+func formatDistance(record models.ExerciseRecord) string {
+	if record.Distance <= 0 {
+		return "-"
+	}
+	if record.ElevationGain > 0 {
+		return fmt.Sprintf("%.2f mi, %.0f ft gain", record.Distance, record.ElevationGain)
+	}
+	return fmt.Sprintf("%.2f mi", record.Distance)
+}
+
+// formatPaceAndSpeed shows pace per mile alongside average speed
+func formatPaceAndSpeed(record models.ExerciseRecord) string {
+	if record.Distance <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s (%.1f mph)", models.FormatPace(record.Pace()), record.Speed())
+}
+
+// formatIntensity shows the RPE when one was given, otherwise the intensity level
+func formatIntensity(record models.ExerciseRecord) string {
+	if record.RPE > 0 {
+		return fmt.Sprintf("RPE %d", record.RPE)
+	}
+	if record.Intensity == "" {
+		return "-"
+	}
+	return string(record.Intensity)
+}
+
 // Helper function to truncate strings for display
 func truncateString(str string, length int) string {
 	if len(str) <= length {
@@ -244,8 +473,9 @@ func truncateString(str string, length int) string {
 	return str[:length-3] + "..."
 }
 
-func ShowExerciseDeleteConfirmation(date string, activity string, otherActivity string, duration int, notes string, completed bool) ConfirmationResult {
+func ShowExerciseDeleteConfirmation(id string, date string, activity string, otherActivity string, duration int, notes string, completed bool) ConfirmationResult {
 	headerColor.Println("\nDelete Confirmation:")
+	fmt.Printf("  ID:         %s\n", id)
 	fmt.Printf("  Date:       %s\n", date)
 	if activity == "other" {
 		fmt.Printf("  Activity:   %s (%s)\n", activity, otherActivity)
@@ -259,3 +489,372 @@ func ShowExerciseDeleteConfirmation(date string, activity string, otherActivity
 	fmt.Printf("  Completed:  %v\n", completed)
 	return ConfirmAction("Are you sure you want to delete this record?")
 }
+
+// ShowFastingRecord displays a formatted fasting record
+func ShowFastingRecord(date string, expected string, actual string, source string, eatingWindow float64, notes string, compliant bool) {
+	headerColor.Println("\nFasting Record:")
+	fmt.Printf("  Date:       %s\n", date)
+	fmt.Printf("  Expected:   %s\n", expected)
+	fmt.Printf("  Actual:     %s\n", actual)
+	if source != "" {
+		fmt.Printf("  Source:     %s\n", source)
+	}
+	if eatingWindow > 0 {
+		fmt.Printf("  Eating:     %.1f hours\n", eatingWindow)
+	}
+	if notes != "" {
+		fmt.Printf("  Notes:      %s\n", notes)
+	}
+	fmt.Printf("  Compliant:  %v\n", compliant)
+}
+
+func ShowFastingList(records []models.FastingRecord) {
+	headerColor.Printf("\n%-12s %-10s %-10s %-10s %-30s %s\n",
+		"Date",
+		"Day",
+		"Expected",
+		"Actual",
+		"Notes",
+		"Compliant")
+	fmt.Println(strings.Repeat("-", 80))
+
+	for _, record := range records {
+		marker := "✓"
+		if !record.IsCompliant() {
+			marker = "✗"
+		}
+
+		fmt.Printf("%-12s %-10s %-10s %-10s %-30s %s\n",
+			record.Date.Format(validator.DateFormat),
+			record.Date.Weekday().String(),
+			record.ExpectedPattern,
+			record.ActualPattern,
+			truncateString(record.Notes, 30),
+			marker)
+	}
+	fmt.Println()
+}
+
+func ShowFastingDeleteConfirmation(date string, expected string, actual string, notes string) ConfirmationResult {
+	headerColor.Println("\nDelete Confirmation:")
+	fmt.Printf("  Date:       %s\n", date)
+	fmt.Printf("  Expected:   %s\n", expected)
+	fmt.Printf("  Actual:     %s\n", actual)
+	if notes != "" {
+		fmt.Printf("  Notes:      %s\n", notes)
+	}
+	return ConfirmAction("Are you sure you want to delete this record?")
+}
+
+// ShowSodaRecord displays a formatted soda record
+func ShowSodaRecord(date string, consumed bool, quantity float64, dailyCap float64, caffeine float64, sugar float64, notes string, compliant bool) {
+	headerColor.Println("\nSoda Record:")
+	fmt.Printf("  Date:       %s\n", date)
+	fmt.Printf("  Consumed:   %v\n", consumed)
+	if consumed {
+		fmt.Printf("  Quantity:   %.1f oz\n", quantity)
+	}
+	fmt.Printf("  Daily Cap:  %.1f oz\n", dailyCap)
+	if caffeine > 0 || sugar > 0 {
+		fmt.Printf("  Caffeine:   %.0f mg\n", caffeine)
+		fmt.Printf("  Sugar:      %.1f g\n", sugar)
+	}
+	if notes != "" {
+		fmt.Printf("  Notes:      %s\n", notes)
+	}
+	fmt.Printf("  Compliant:  %v\n", compliant)
+}
+
+func ShowSodaList(records []models.SodaRecord, compliance []bool, allowance models.SodaAllowance) {
+	headerColor.Printf("\n%-12s %-10s %-8s %-8s %-8s %-30s %s\n",
+		"Date",
+		"Day",
+		"Period",
+		"Ounces",
+		"Cap",
+		"Notes",
+		"Compliant")
+	fmt.Println(strings.Repeat("-", 90))
+
+	for i, record := range records {
+		period := "weekday"
+		if record.IsWeekend() {
+			period = "weekend"
+		}
+
+		dailyCap := fmt.Sprintf("%.1f", allowance.DailyCap(record.Date.Weekday()))
+
+		marker := "✓"
+		if !compliance[i] {
+			marker = "✗"
+		}
+
+		fmt.Printf("%-12s %-10s %-8s %-8.1f %-8s %-30s %s\n",
+			record.Date.Format(validator.DateFormat),
+			record.Date.Weekday().String(),
+			period,
+			record.Quantity,
+			dailyCap,
+			truncateString(record.Notes, 30),
+			marker)
+	}
+	fmt.Println()
+}
+
+func ShowSodaDeleteConfirmation(date string, consumed bool, quantity float64, notes string) ConfirmationResult {
+	headerColor.Println("\nDelete Confirmation:")
+	fmt.Printf("  Date:       %s\n", date)
+	fmt.Printf("  Consumed:   %v\n", consumed)
+	if consumed {
+		fmt.Printf("  Quantity:   %.1f oz\n", quantity)
+	}
+	if notes != "" {
+		fmt.Printf("  Notes:      %s\n", notes)
+	}
+	return ConfirmAction("Are you sure you want to delete this record?")
+}
+
+// ShowFastingSchedule displays the meal pattern expected on each weekday
+func ShowFastingSchedule(schedule models.FastingSchedule) {
+	title := "Fasting Schedule"
+	if schedule.Name != "" {
+		title = fmt.Sprintf("Fasting Schedule (%s)", schedule.Name)
+	}
+	headerColor.Printf("\n%s:\n", title)
+	if !schedule.EffectiveFrom.IsZero() {
+		fmt.Printf("  Effective:  %s\n", schedule.EffectiveFrom.Format(validator.DateFormat))
+	}
+
+	// Show the week starting on Monday
+	for i := 1; i <= 7; i++ {
+		weekday := time.Weekday(i % 7)
+		fmt.Printf("  %-10s  %s\n", weekday.String()+":", schedule.Pattern(weekday))
+	}
+	fmt.Println()
+}
+
+// ShowSodaAllowance displays the daily caps and weekly budget
+func ShowSodaAllowance(allowance models.SodaAllowance) {
+	headerColor.Println("\nSoda Allowance:")
+
+	// Show the week starting on Monday
+	for i := 1; i <= 7; i++ {
+		weekday := time.Weekday(i % 7)
+		fmt.Printf("  %-14s  %.1f oz\n", weekday.String()+":", allowance.DailyCap(weekday))
+	}
+	if allowance.WeeklyBudget > 0 {
+		fmt.Printf("  %-14s  %.1f oz\n", "Weekly Budget:", allowance.WeeklyBudget)
+		fmt.Printf("  %-14s  %v\n", "Rollover:", allowance.Rollover)
+	} else {
+		fmt.Printf("  %-14s  none\n", "Weekly Budget:")
+	}
+	fmt.Println()
+}
+
+// ShowExerciseGoal displays the daily and weekly exercise targets
+func ShowExerciseGoal(goal models.ExerciseGoal) {
+	headerColor.Println("\nExercise Goals:")
+	if !goal.EffectiveFrom.IsZero() {
+		fmt.Printf("  %-18s  %s\n", "Effective:", goal.EffectiveFrom.Format(validator.DateFormat))
+	}
+
+	showTarget := func(label string, value int, unit string) {
+		if value > 0 {
+			fmt.Printf("  %-18s  %d %s\n", label, value, unit)
+		} else {
+			fmt.Printf("  %-18s  none\n", label)
+		}
+	}
+	showTarget("Daily:", goal.DailyMinutes, "minutes")
+
+	weeklyUnit := "minutes"
+	if goal.WeeklyIntensity != "" {
+		weeklyUnit = fmt.Sprintf("minutes at %s or above", goal.WeeklyIntensity)
+	}
+	showTarget("Weekly:", goal.WeeklyMinutes, weeklyUnit)
+	showTarget("Sessions Per Week:", goal.SessionsPerWeek, "sessions")
+
+	activities := make([]string, 0, len(goal.ActivityTargets))
+	for activity := range goal.ActivityTargets {
+		activities = append(activities, string(activity))
+	}
+	sort.Strings(activities)
+	for _, activity := range activities {
+		fmt.Printf("  %-18s  %d minutes per week\n", activity+":", goal.ActivityTargets[models.ActivityType(activity)])
+	}
+
+	counted := "completed sessions only"
+	if goal.CountIncomplete {
+		counted = "all sessions"
+	}
+	fmt.Printf("  %-18s  %s\n", "Counting:", counted)
+	fmt.Println()
+}
+
+// ShowFastingWindow displays a timed fast, with elapsed time if it is still running
+func ShowFastingWindow(window models.FastingWindow, now time.Time) {
+	if window.InProgress() {
+		headerColor.Println("\nFast In Progress:")
+	} else {
+		headerColor.Println("\nFast:")
+	}
+	fmt.Printf("  Started:    %s\n", window.Start.Format(validator.DateTimeFormat))
+	if window.End != nil {
+		fmt.Printf("  Ended:      %s\n", window.End.Format(validator.DateTimeFormat))
+		fmt.Printf("  Duration:   %.1f hours\n", window.Duration)
+	} else {
+		fmt.Printf("  Elapsed:    %.1f hours\n", window.Elapsed(now).Hours())
+	}
+	if window.Notes != "" {
+		fmt.Printf("  Notes:      %s\n", window.Notes)
+	}
+}
+
+func ShowFastingWindowList(windows []models.FastingWindow, now time.Time) {
+	headerColor.Printf("\n%-17s %-17s %-10s %-30s\n",
+		"Started",
+		"Ended",
+		"Hours",
+		"Notes")
+	fmt.Println(strings.Repeat("-", 80))
+
+	for _, window := range windows {
+		end := "in progress"
+		if window.End != nil {
+			end = window.End.Format(validator.DateTimeFormat)
+		}
+
+		fmt.Printf("%-17s %-17s %-10.1f %-30s\n",
+			window.Start.Format(validator.DateTimeFormat),
+			end,
+			window.Elapsed(now).Hours(),
+			truncateString(window.Notes, 30))
+	}
+	fmt.Println()
+}
+
+// ShowMealEntry displays a logged meal
+func ShowMealEntry(meal models.MealEntry) {
+	headerColor.Println("\nMeal:")
+	fmt.Printf("  Time:       %s\n", meal.Time.Format(validator.DateTimeFormat))
+	if meal.Notes != "" {
+		fmt.Printf("  Notes:      %s\n", meal.Notes)
+	}
+}
+
+// ShowSodaEntry displays a single logged drink
+func ShowSodaEntry(entry models.SodaEntry) {
+	headerColor.Println("\nDrink:")
+	fmt.Printf("  Time:       %s\n", entry.Time.Format(validator.DateTimeFormat))
+	fmt.Printf("  Beverage:   %s\n", entry.Beverage)
+	fmt.Printf("  Size:       %.1f oz\n", entry.Size)
+	fmt.Printf("  Caffeine:   %.0f mg\n", entry.Caffeine)
+	fmt.Printf("  Sugar:      %.1f g\n", entry.Sugar)
+	if entry.Notes != "" {
+		fmt.Printf("  Notes:      %s\n", entry.Notes)
+	}
+}
+
+func ShowSodaEntryList(entries []models.SodaEntry) {
+	rows := make([][]string, len(entries))
+	for i, entry := range entries {
+		rows[i] = []string{
+			entry.Time.Format(validator.DateTimeFormat),
+			entry.Beverage,
+			fmt.Sprintf("%.1f", entry.Size),
+			fmt.Sprintf("%.0f", entry.Caffeine),
+			fmt.Sprintf("%.1f", entry.Sugar),
+			truncateString(entry.Notes, 30),
+		}
+	}
+	ShowTable([]string{"Time", "Beverage", "Ounces", "Caffeine (mg)", "Sugar (g)", "Notes"}, rows)
+}
+
+func ShowBeverageCatalog(catalog models.BeverageCatalog) {
+	headerColor.Println("\nBeverage Catalog:")
+	rows := make([][]string, len(catalog))
+	for i, beverage := range catalog {
+		rows[i] = []string{
+			beverage.Name,
+			fmt.Sprintf("%.2f", beverage.CaffeinePerOz),
+			fmt.Sprintf("%.2f", beverage.SugarPerOz),
+			fmt.Sprintf("%.0f mg / %.0f g", beverage.CaffeinePerOz*12, beverage.SugarPerOz*12),
+		}
+	}
+	ShowTable([]string{"Name", "Caffeine (mg/oz)", "Sugar (g/oz)", "Per 12 oz"}, rows)
+}
+
+// ShowActivity displays a single activity catalog entry
+func ShowActivity(activity models.Activity) {
+	headerColor.Println("\nActivity:")
+	fmt.Printf("  Name:       %s\n", activity.Name)
+	fmt.Printf("  Category:   %s\n", activity.Category)
+	if len(activity.Aliases) > 0 {
+		fmt.Printf("  Aliases:    %s\n", strings.Join(activity.Aliases, ", "))
+	}
+	if activity.METs != nil {
+		fmt.Printf("  METs:       %.1f light, %.1f moderate, %.1f vigorous\n",
+			activity.METs.Light, activity.METs.Moderate, activity.METs.Vigorous)
+	}
+	if activity.Archived {
+		fmt.Printf("  Archived:   true\n")
+	}
+}
+
+func ShowActivityCatalog(catalog models.ActivityCatalog) {
+	rows := make([][]string, len(catalog))
+	for i, activity := range catalog {
+		status := "active"
+		if activity.Archived {
+			status = "archived"
+		}
+		rows[i] = []string{
+			string(activity.Name),
+			string(activity.Category),
+			strings.Join(activity.Aliases, ", "),
+			status,
+		}
+	}
+	ShowTable([]string{"Name", "Category", "Aliases", "Status"}, rows)
+}
+
+// gearStatus describes whether gear is in use, worn out or retired
+func gearStatus(usage models.GearUsage) string {
+	switch {
+	case usage.Gear.Retired:
+		return "retired"
+	case usage.PastRetirement():
+		return "past threshold"
+	case usage.Gear.Default:
+		return "default"
+	}
+	return "active"
+}
+
+// ShowGear displays a piece of gear with its accumulated usage
+func ShowGear(usage models.GearUsage) {
+	headerColor.Println("\nGear:")
+	fmt.Printf("  ID:         %s\n", usage.Gear.ID)
+	fmt.Printf("  Name:       %s\n", usage.Gear.Name)
+	fmt.Printf("  Activity:   %s\n", usage.Gear.Activity)
+	fmt.Printf("  Usage:      %.1f mi, %.1f h over %d session(s)\n", usage.Miles(), usage.Hours(), usage.Sessions)
+	fmt.Printf("  Retire At:  %s\n", usage.Gear.FormatRetireAt())
+	fmt.Printf("  Status:     %s\n", gearStatus(usage))
+}
+
+func ShowGearList(usage []models.GearUsage) {
+	rows := make([][]string, len(usage))
+	for i, u := range usage {
+		rows[i] = []string{
+			u.Gear.ID,
+			u.Gear.Name,
+			string(u.Gear.Activity),
+			fmt.Sprintf("%d", u.Sessions),
+			fmt.Sprintf("%.1f", u.Miles()),
+			fmt.Sprintf("%.1f", u.Hours()),
+			u.Gear.FormatRetireAt(),
+			gearStatus(u),
+		}
+	}
+	ShowTable([]string{"ID", "Name", "Activity", "Sessions", "Miles", "Hours", "Retire At", "Status"}, rows)
+}
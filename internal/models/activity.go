@@ -0,0 +1,166 @@
+// internal/models/activity.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ActivityCategory groups activities for reporting
+type ActivityCategory string
+
+const (
+	CategoryCardio   ActivityCategory = "cardio"
+	CategoryStrength ActivityCategory = "strength"
+	CategorySport    ActivityCategory = "sport"
+)
+
+// ParseActivityCategory converts a command line value into an ActivityCategory
+func ParseActivityCategory(category string) (ActivityCategory, error) {
+	switch c := ActivityCategory(strings.ToLower(category)); c {
+	case CategoryCardio, CategoryStrength, CategorySport:
+		return c, nil
+	}
+	return "", fmt.Errorf("invalid activity category: %s (use cardio, strength or sport)", category)
+}
+
+// categoryKeywords are words in an activity name that suggest its category
+var categoryKeywords = map[ActivityCategory][]string{
+	CategoryStrength: {"lift", "weight", "strength", "crossfit", "pilates", "yoga", "calisthenics", "kettlebell", "pushup", "pullup"},
+	CategorySport:    {"ball", "tennis", "golf", "squash", "badminton", "soccer", "hockey", "cricket", "rugby", "climb", "boxing", "martial", "karate", "judo"},
+}
+
+// GuessActivityCategory suggests a category for a free-text activity name,
+// falling back to cardio when nothing in the name suggests another
+func GuessActivityCategory(name string) ActivityCategory {
+	key := string(NormalizeActivityName(name))
+	for _, category := range []ActivityCategory{CategoryStrength, CategorySport} {
+		for _, keyword := range categoryKeywords[category] {
+			if strings.Contains(key, keyword) {
+				return category
+			}
+		}
+	}
+	return CategoryCardio
+}
+
+// Activity is a catalog entry that exercise records refer to by name
+type Activity struct {
+	Name     ActivityType     `json:"name"`
+	Category ActivityCategory `json:"category"`
+	Aliases  []string         `json:"aliases,omitempty"`
+	METs     *METs            `json:"mets,omitempty"`     // overrides the built-in MET table for calorie estimates
+	Archived bool             `json:"archived,omitempty"` // kept for history but not offered for new records
+}
+
+// ActivityCatalog is the user-maintained list of activities
+type ActivityCatalog []Activity
+
+// DefaultActivityCatalog returns the activities the tracker started with
+func DefaultActivityCatalog() ActivityCatalog {
+	return ActivityCatalog{
+		{Name: Cycling, Category: CategoryCardio, Aliases: []string{"bike", "biking"}},
+		{Name: Jogging, Category: CategoryCardio, Aliases: []string{"run", "running"}},
+		{Name: MountainBike, Category: CategoryCardio, Aliases: []string{"mtb"}},
+		{Name: Pickleball, Category: CategorySport},
+		{Name: Skiing, Category: CategoryCardio, Aliases: []string{"ski"}},
+		{Name: Walking, Category: CategoryCardio, Aliases: []string{"walk"}},
+		{Name: WeightLifting, Category: CategoryStrength, Aliases: []string{"lifting", "weights"}},
+	}
+}
+
+// NormalizeActivityName turns free text such as "Mountain Biking" into the
+// stored form "mountain_biking"
+func NormalizeActivityName(name string) ActivityType {
+	fields := strings.Fields(strings.ToLower(strings.ReplaceAll(name, "-", " ")))
+	return ActivityType(strings.Join(fields, "_"))
+}
+
+// Find looks up an activity by name or alias, ignoring case and spacing
+func (c ActivityCatalog) Find(name string) *Activity {
+	key := NormalizeActivityName(name)
+	for i := range c {
+		if c[i].Name == key {
+			return &c[i]
+		}
+	}
+	for i := range c {
+		for _, alias := range c[i].Aliases {
+			if NormalizeActivityName(alias) == key {
+				return &c[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Add appends a new activity, rejecting names or aliases already in use
+func (c *ActivityCatalog) Add(activity Activity) error {
+	activity.Name = NormalizeActivityName(string(activity.Name))
+	if activity.Name == "" {
+		return fmt.Errorf("activity name is required")
+	}
+	if activity.Name == Other {
+		return fmt.Errorf("'%s' is reserved; give the activity its own name", Other)
+	}
+	for _, name := range append([]string{string(activity.Name)}, activity.Aliases...) {
+		if existing := c.Find(name); existing != nil {
+			return fmt.Errorf("'%s' is already used by activity %s", name, existing.Name)
+		}
+	}
+	*c = append(*c, activity)
+	c.sort()
+	return nil
+}
+
+// AddAliases attaches extra names to an existing activity
+func (c ActivityCatalog) AddAliases(name ActivityType, aliases []string) error {
+	activity := c.Find(string(name))
+	if activity == nil {
+		return fmt.Errorf("activity not found: %s", name)
+	}
+	for _, alias := range aliases {
+		if existing := c.Find(alias); existing != nil {
+			if existing.Name == activity.Name {
+				continue
+			}
+			return fmt.Errorf("'%s' is already used by activity %s", alias, existing.Name)
+		}
+		activity.Aliases = append(activity.Aliases, alias)
+	}
+	return nil
+}
+
+// Rename changes an activity's name, keeping the old name as an alias so
+// anything typed out of habit still resolves
+func (c *ActivityCatalog) Rename(oldName, newName string) (ActivityType, ActivityType, error) {
+	activity := c.Find(oldName)
+	if activity == nil {
+		return "", "", fmt.Errorf("activity not found: %s", oldName)
+	}
+	from := activity.Name
+	to := NormalizeActivityName(newName)
+	if to == "" || to == Other {
+		return "", "", fmt.Errorf("invalid activity name: %s", newName)
+	}
+	if existing := c.Find(string(to)); existing != nil && existing.Name != from {
+		return "", "", fmt.Errorf("'%s' is already used by activity %s", newName, existing.Name)
+	}
+
+	// Drop the new name from the aliases if it was one
+	aliases := []string{string(from)}
+	for _, alias := range activity.Aliases {
+		if NormalizeActivityName(alias) != to {
+			aliases = append(aliases, alias)
+		}
+	}
+	activity.Name = to
+	activity.Aliases = aliases
+	c.sort()
+	return from, to, nil
+}
+
+func (c ActivityCatalog) sort() {
+	sort.Slice(c, func(i, j int) bool { return c[i].Name < c[j].Name })
+}
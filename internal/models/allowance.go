@@ -0,0 +1,165 @@
+// internal/models/allowance.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SodaAllowance describes how much soda is allowed per day and per week
+type SodaAllowance struct {
+	DailyCaps    map[string]float64 `json:"daily_caps,omitempty"`    // oz per day, keyed by lowercase weekday name
+	WeeklyBudget float64            `json:"weekly_budget,omitempty"` // oz per week, 0 for no weekly budget
+	Rollover     bool               `json:"rollover,omitempty"`      // carry last week's unused budget forward
+}
+
+// SodaWeek summarises soda consumption against the budget for a Monday-Sunday week
+type SodaWeek struct {
+	Start   time.Time
+	Used    float64
+	Budget  float64
+	Carried float64 // unused budget rolled over from the previous week
+}
+
+// DefaultSodaAllowance is no soda Monday-Thursday and up to 12 oz Friday-Sunday
+func DefaultSodaAllowance() SodaAllowance {
+	allowance := SodaAllowance{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		limit := 0.0
+		if day == time.Friday || day == time.Saturday || day == time.Sunday {
+			limit = 12.0
+		}
+		allowance.SetDailyCap(day, limit)
+	}
+	return allowance
+}
+
+// DailyCap returns the maximum ounces allowed on the given weekday
+func (a SodaAllowance) DailyCap(weekday time.Weekday) float64 {
+	if limit, ok := a.DailyCaps[weekdayKey(weekday)]; ok {
+		return limit
+	}
+	return DefaultSodaAllowance().DailyCaps[weekdayKey(weekday)]
+}
+
+// SetDailyCap sets the maximum ounces allowed on the given weekday
+func (a *SodaAllowance) SetDailyCap(weekday time.Weekday, limit float64) {
+	if a.DailyCaps == nil {
+		a.DailyCaps = make(map[string]float64)
+	}
+	a.DailyCaps[weekdayKey(weekday)] = limit
+}
+
+func (a SodaAllowance) Validate() error {
+	for day, limit := range a.DailyCaps {
+		if _, err := ParseWeekday(day); err != nil {
+			return err
+		}
+		if limit < 0 || limit > 64 {
+			return fmt.Errorf("daily cap for %s must be between 0 and 64 oz", day)
+		}
+	}
+	if a.WeeklyBudget < 0 || a.WeeklyBudget > 7*64 {
+		return fmt.Errorf("weekly budget must be between 0 and %d oz", 7*64)
+	}
+	if a.Rollover && a.WeeklyBudget == 0 {
+		return fmt.Errorf("rollover requires a weekly budget")
+	}
+	return nil
+}
+
+// Weeks groups records into Monday-Sunday weeks and works out each week's
+// available budget, including any rollover from the week before
+func (a SodaAllowance) Weeks(records []SodaRecord) map[time.Time]*SodaWeek {
+	return a.weeks(records)
+}
+
+// Week works out the budget for the week containing date, which may have no
+// records yet but still receives rollover from the week before
+func (a SodaAllowance) Week(records []SodaRecord, date time.Time) SodaWeek {
+	start := WeekStart(date)
+	return *a.weeks(records, start)[start]
+}
+
+// weeks groups records into weeks as Weeks, also including the weeks starting
+// on each of seeds
+func (a SodaAllowance) weeks(records []SodaRecord, seeds ...time.Time) map[time.Time]*SodaWeek {
+	weeks := make(map[time.Time]*SodaWeek)
+	for _, start := range seeds {
+		weeks[start] = &SodaWeek{Start: start, Budget: a.WeeklyBudget}
+	}
+	for _, record := range records {
+		start := WeekStart(record.Date)
+		week, ok := weeks[start]
+		if !ok {
+			week = &SodaWeek{Start: start, Budget: a.WeeklyBudget}
+			weeks[start] = week
+		}
+		if record.Consumed {
+			week.Used += record.Quantity
+		}
+	}
+
+	if a.Rollover {
+		for start, week := range weeks {
+			// Only carry budget forward from weeks that were actually logged
+			previous, ok := weeks[start.AddDate(0, 0, -7)]
+			if !ok {
+				continue
+			}
+			if unused := previous.Budget - previous.Used; unused > 0 {
+				week.Carried = unused
+			}
+		}
+	}
+
+	return weeks
+}
+
+// OverBudget reports, per record date, whether the week's running total had
+// exceeded the available weekly budget by the end of that day
+func (a SodaAllowance) OverBudget(records []SodaRecord) map[time.Time]bool {
+	over := make(map[time.Time]bool)
+	if a.WeeklyBudget == 0 {
+		return over
+	}
+
+	weeks := a.Weeks(records)
+	running := make(map[time.Time]float64)
+	for _, record := range sortedSodaRecords(records) {
+		start := WeekStart(record.Date)
+		if record.Consumed {
+			running[start] += record.Quantity
+		}
+		over[record.Date] = running[start] > weeks[start].Available()
+	}
+
+	return over
+}
+
+// Available returns the total ounces allowed this week
+func (w SodaWeek) Available() float64 {
+	return w.Budget + w.Carried
+}
+
+// Remaining returns the ounces still available this week
+func (w SodaWeek) Remaining() float64 {
+	return w.Available() - w.Used
+}
+
+// WeekStart returns midnight UTC on the Monday of the week containing date
+func WeekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+func sortedSodaRecords(records []SodaRecord) []SodaRecord {
+	sorted := make([]SodaRecord, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+	return sorted
+}
@@ -0,0 +1,167 @@
+// internal/models/bests.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BestKind names a kind of personal best
+type BestKind string
+
+const (
+	LongestSession    BestKind = "Longest Session"
+	LongestDistance   BestKind = "Longest Distance"
+	FastestPace       BestKind = "Fastest Pace"
+	BestOneRepMax     BestKind = "Best Est. 1RM"
+	MostDailyMinutes  BestKind = "Most Minutes in a Day"
+	MostWeeklyMinutes BestKind = "Most Minutes in a Week"
+	LongestStreak     BestKind = "Longest Compliant Streak"
+)
+
+// minPaceDistance keeps short efforts out of the fastest pace, in miles
+const minPaceDistance = 1.0
+
+// PersonalBest is the best result of one kind, for an activity or lift or
+// across all exercise
+type PersonalBest struct {
+	Kind     BestKind
+	Subject  string // activity or lift name, empty for overall bests
+	Value    float64
+	Date     time.Time // the session date, or the start of a week or streak
+	Through  time.Time // the end of a week or streak
+	RecordID string    // the session that set it, for single-session bests
+}
+
+// Key identifies what the best is for, so bests can be compared over time
+func (b PersonalBest) Key() string {
+	return string(b.Kind) + "/" + b.Subject
+}
+
+// Beats reports whether b is strictly better than other. Pace is better when lower.
+func (b PersonalBest) Beats(other PersonalBest) bool {
+	if b.Kind == FastestPace {
+		return b.Value < other.Value
+	}
+	return b.Value > other.Value
+}
+
+// FormatValue shows the best in the units of its kind
+func (b PersonalBest) FormatValue() string {
+	switch b.Kind {
+	case LongestSession, MostDailyMinutes, MostWeeklyMinutes:
+		return fmt.Sprintf("%.0f minutes", b.Value)
+	case LongestDistance:
+		return fmt.Sprintf("%.2f mi", b.Value)
+	case FastestPace:
+		return FormatPace(b.Value)
+	case BestOneRepMax:
+		return fmt.Sprintf("%.1f lbs", b.Value)
+	case LongestStreak:
+		return fmt.Sprintf("%.0f days", b.Value)
+	}
+	return fmt.Sprintf("%.1f", b.Value)
+}
+
+// String describes the best for success messages
+func (b PersonalBest) String() string {
+	if b.Subject == "" {
+		return fmt.Sprintf("%s: %s", b.Kind, b.FormatValue())
+	}
+	return fmt.Sprintf("%s (%s): %s", b.Kind, b.Subject, b.FormatValue())
+}
+
+// ComputePersonalBests finds the bests across completed sessions. Compliant
+// streaks are judged against the goal in force on each day. Ties go to the
+// earlier session.
+func ComputePersonalBests(records []ExerciseRecord, goals ExerciseGoals) []PersonalBest {
+	sorted := make([]ExerciseRecord, 0, len(records))
+	for _, record := range records {
+		if record.Completed {
+			sorted = append(sorted, record)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	bests := make(map[string]PersonalBest)
+	var order []string
+	consider := func(candidate PersonalBest) {
+		key := candidate.Key()
+		current, ok := bests[key]
+		if !ok {
+			order = append(order, key)
+		}
+		if !ok || candidate.Beats(current) {
+			bests[key] = candidate
+		}
+	}
+
+	weekly := make(map[time.Time]float64)
+	var weeks []time.Time
+	for _, record := range sorted {
+		activity := string(record.Activity)
+		consider(PersonalBest{Kind: LongestSession, Subject: activity, Value: float64(record.Duration), Date: record.Date, RecordID: record.ID})
+		if record.Distance > 0 {
+			consider(PersonalBest{Kind: LongestDistance, Subject: activity, Value: record.Distance, Date: record.Date, RecordID: record.ID})
+		}
+		if record.Distance >= minPaceDistance {
+			consider(PersonalBest{Kind: FastestPace, Subject: activity, Value: record.Pace(), Date: record.Date, RecordID: record.ID})
+		}
+		for _, lift := range record.Lifts {
+			if oneRepMax := lift.OneRepMax(Epley); oneRepMax > 0 {
+				consider(PersonalBest{Kind: BestOneRepMax, Subject: lift.Name, Value: oneRepMax, Date: record.Date, RecordID: record.ID})
+			}
+		}
+
+		start := WeekStart(record.Date)
+		if _, ok := weekly[start]; !ok {
+			weeks = append(weeks, start)
+		}
+		weekly[start] += float64(record.Duration)
+	}
+
+	for _, start := range weeks {
+		consider(PersonalBest{Kind: MostWeeklyMinutes, Value: weekly[start], Date: start, Through: start.AddDate(0, 0, 6)})
+	}
+
+	var streak PersonalBest
+	for _, day := range GroupExerciseByDay(sorted) {
+		consider(PersonalBest{Kind: MostDailyMinutes, Value: float64(day.TotalMinutes), Date: day.Date})
+
+		if !goals.GoalFor(day.Date).MeetsDaily(day) {
+			streak = PersonalBest{}
+			continue
+		}
+		if streak.Value > 0 && day.Date.Equal(streak.Through.AddDate(0, 0, 1)) {
+			streak.Value++
+		} else {
+			streak = PersonalBest{Kind: LongestStreak, Value: 1, Date: day.Date}
+		}
+		streak.Through = day.Date
+		consider(streak)
+	}
+
+	result := make([]PersonalBest, len(order))
+	for i, key := range order {
+		result[i] = bests[key]
+	}
+	return result
+}
+
+// NewPersonalBests returns the bests in after that improve on an existing best
+// in before. A first result for an activity or lift is not counted as a new best.
+func NewPersonalBests(before, after []PersonalBest) []PersonalBest {
+	previous := make(map[string]PersonalBest, len(before))
+	for _, best := range before {
+		previous[best.Key()] = best
+	}
+
+	var improved []PersonalBest
+	for _, best := range after {
+		if old, ok := previous[best.Key()]; ok && best.Beats(old) {
+			improved = append(improved, best)
+		}
+	}
+	return improved
+}
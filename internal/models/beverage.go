@@ -0,0 +1,119 @@
+// internal/models/beverage.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Beverage is a catalog entry with its nutrition per fluid ounce
+type Beverage struct {
+	Name          string  `json:"name"`
+	CaffeinePerOz float64 `json:"caffeine_per_oz"` // in mg
+	SugarPerOz    float64 `json:"sugar_per_oz"`    // in g
+}
+
+func (b Beverage) Validate() error {
+	if strings.TrimSpace(b.Name) == "" {
+		return fmt.Errorf("beverage name is required")
+	}
+	if b.CaffeinePerOz < 0 || b.CaffeinePerOz > 100 {
+		return fmt.Errorf("caffeine must be between 0 and 100 mg per oz")
+	}
+	if b.SugarPerOz < 0 || b.SugarPerOz > 30 {
+		return fmt.Errorf("sugar must be between 0 and 30 g per oz")
+	}
+	return nil
+}
+
+// BeverageCatalog is the user-maintained list of drinks that can be logged
+type BeverageCatalog []Beverage
+
+// Find looks up a beverage by name, ignoring case
+func (c BeverageCatalog) Find(name string) *Beverage {
+	for i := range c {
+		if strings.EqualFold(c[i].Name, strings.TrimSpace(name)) {
+			return &c[i]
+		}
+	}
+	return nil
+}
+
+// Set adds a beverage or replaces the one with the same name, keeping the
+// catalog sorted by name
+func (c *BeverageCatalog) Set(beverage Beverage) {
+	beverage.Name = strings.TrimSpace(beverage.Name)
+	if existing := c.Find(beverage.Name); existing != nil {
+		*existing = beverage
+	} else {
+		*c = append(*c, beverage)
+	}
+	sort.Slice(*c, func(i, j int) bool {
+		return strings.ToLower((*c)[i].Name) < strings.ToLower((*c)[j].Name)
+	})
+}
+
+// Remove deletes a beverage by name
+func (c *BeverageCatalog) Remove(name string) error {
+	for i := range *c {
+		if strings.EqualFold((*c)[i].Name, strings.TrimSpace(name)) {
+			*c = append((*c)[:i], (*c)[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("beverage not found: %s", name)
+}
+
+// SodaEntry is a single drink. Nutrition is worked out from the catalog when
+// the drink is logged so later catalog edits don't rewrite history.
+type SodaEntry struct {
+	Time     time.Time `json:"time"`
+	Beverage string    `json:"beverage"`
+	Size     float64   `json:"size"`     // in oz
+	Caffeine float64   `json:"caffeine"` // in mg
+	Sugar    float64   `json:"sugar"`    // in g
+	Notes    string    `json:"notes,omitempty"`
+}
+
+// NewSodaEntry builds an entry for a serving of a catalog beverage
+func NewSodaEntry(at time.Time, beverage Beverage, size float64, notes string) SodaEntry {
+	return SodaEntry{
+		Time:     at,
+		Beverage: beverage.Name,
+		Size:     size,
+		Caffeine: beverage.CaffeinePerOz * size,
+		Sugar:    beverage.SugarPerOz * size,
+		Notes:    notes,
+	}
+}
+
+func (e SodaEntry) GetDate() time.Time {
+	return e.Time
+}
+
+func (e SodaEntry) Validate() error {
+	if e.Time.IsZero() {
+		return fmt.Errorf("drink time is required")
+	}
+	if e.Size <= 0 {
+		return fmt.Errorf("size must be greater than 0")
+	}
+	if e.Size > 64 { // reasonable upper limit for a single drink
+		return fmt.Errorf("size seems unreasonably high")
+	}
+	return nil
+}
+
+// ApplyEntries derives the day's consumption and nutrition from its drinks
+func (s *SodaRecord) ApplyEntries(entries []SodaEntry) {
+	s.Quantity, s.Caffeine, s.Sugar = 0, 0, 0
+	for _, entry := range entries {
+		s.Quantity += entry.Size
+		s.Caffeine += entry.Caffeine
+		s.Sugar += entry.Sugar
+	}
+	s.Consumed = s.Quantity > 0
+	s.Entries = len(entries)
+}
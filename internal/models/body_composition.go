@@ -0,0 +1,85 @@
+// internal/models/body_composition.go
+package models
+
+import "fmt"
+
+// validateComposition checks the body composition fields that were measured
+func (w WeightRecord) validateComposition() error {
+	if w.BodyFat != 0 && (w.BodyFat < 2 || w.BodyFat > 75) {
+		return fmt.Errorf("body fat must be between 2%% and 75%%")
+	}
+	if w.Water != 0 && (w.Water < 20 || w.Water > 80) {
+		return fmt.Errorf("water must be between 20%% and 80%%")
+	}
+	if w.VisceralFat != 0 && (w.VisceralFat < 1 || w.VisceralFat > 59) {
+		return fmt.Errorf("visceral fat rating must be between 1 and 59")
+	}
+	if w.MuscleMass < 0 {
+		return fmt.Errorf("muscle mass cannot be negative")
+	}
+	if w.MuscleMass >= w.Weight && w.MuscleMass > 0 {
+		return fmt.Errorf("muscle mass must be less than the weight")
+	}
+	// Muscle is part of lean mass, so it can't leave less room than the fat needs
+	if fat, ok := w.FatMass(); ok && w.MuscleMass > w.Weight-fat {
+		return fmt.Errorf("muscle mass must not exceed lean mass at %.1f%% body fat", w.BodyFat)
+	}
+	return nil
+}
+
+// HasComposition reports whether any body composition was measured
+func (w WeightRecord) HasComposition() bool {
+	return w.BodyFat != 0 || w.MuscleMass != 0 || w.Water != 0 || w.VisceralFat != 0
+}
+
+// FatMass returns the weight of body fat in pounds. It reports false when
+// body fat wasn't measured.
+func (w WeightRecord) FatMass() (float64, bool) {
+	if w.BodyFat == 0 {
+		return 0, false
+	}
+	return w.Weight * w.BodyFat / 100, true
+}
+
+// LeanMass returns everything but body fat, in pounds. It reports false when
+// body fat wasn't measured.
+func (w WeightRecord) LeanMass() (float64, bool) {
+	fat, ok := w.FatMass()
+	if !ok {
+		return 0, false
+	}
+	return w.Weight - fat, true
+}
+
+// CompositionTrend is the smoothed fat and lean mass on the date of a
+// weigh-in with body fat measured
+type CompositionTrend struct {
+	RecordID string
+	FatMass  TrendPoint
+	LeanMass TrendPoint
+}
+
+// ComputeCompositionTrend smooths fat and lean mass the same way as weight,
+// over the weigh-ins with body fat measured, in date order
+func ComputeCompositionTrend(records []WeightRecord, smoothing float64) []CompositionTrend {
+	var fat, lean []WeightRecord
+	for _, record := range records {
+		fatMass, ok := record.FatMass()
+		if !ok {
+			continue
+		}
+		fatRecord, leanRecord := record, record
+		fatRecord.Weight = fatMass
+		leanRecord.Weight = record.Weight - fatMass
+		fat = append(fat, fatRecord)
+		lean = append(lean, leanRecord)
+	}
+
+	fatPoints := ComputeWeightTrend(fat, smoothing)
+	leanPoints := ComputeWeightTrend(lean, smoothing)
+	trend := make([]CompositionTrend, len(fatPoints))
+	for i := range fatPoints {
+		trend[i] = CompositionTrend{RecordID: fatPoints[i].RecordID, FatMass: fatPoints[i], LeanMass: leanPoints[i]}
+	}
+	return trend
+}
@@ -0,0 +1,140 @@
+// internal/models/distance.go
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// DistanceUnit is the unit a distance was entered in. Records store miles and feet.
+type DistanceUnit string
+
+const (
+	Miles      DistanceUnit = "mi"
+	Kilometers DistanceUnit = "km"
+
+	kmPerMile    = 1.609344
+	feetPerMeter = 3.28084
+)
+
+// ParseDistanceUnit converts a command line value into a DistanceUnit
+func ParseDistanceUnit(unit string) (DistanceUnit, error) {
+	switch strings.ToLower(unit) {
+	case "mi", "mile", "miles":
+		return Miles, nil
+	case "km", "kilometer", "kilometers", "kilometre", "kilometres":
+		return Kilometers, nil
+	}
+	return "", fmt.Errorf("invalid distance unit: %s (use mi or km)", unit)
+}
+
+// ToMiles converts a distance in this unit to miles
+func (u DistanceUnit) ToMiles(distance float64) float64 {
+	if u == Kilometers {
+		return distance / kmPerMile
+	}
+	return distance
+}
+
+// ToFeet converts an elevation in this unit's system (feet for miles, meters
+// for kilometers) to feet
+func (u DistanceUnit) ToFeet(elevation float64) float64 {
+	if u == Kilometers {
+		return elevation * feetPerMeter
+	}
+	return elevation
+}
+
+// FromMiles converts a distance in miles to this unit
+func (u DistanceUnit) FromMiles(miles float64) float64 {
+	if u == Kilometers {
+		return miles * kmPerMile
+	}
+	return miles
+}
+
+// FromFeet converts an elevation in feet to this unit's system
+func (u DistanceUnit) FromFeet(feet float64) float64 {
+	if u == Kilometers {
+		return feet / feetPerMeter
+	}
+	return feet
+}
+
+// FormatDistance shows a distance in miles in this unit
+func (u DistanceUnit) FormatDistance(miles float64) string {
+	return fmt.Sprintf("%.2f %s", u.FromMiles(miles), u)
+}
+
+// FormatElevation shows an elevation in feet in this unit's system
+func (u DistanceUnit) FormatElevation(feet float64) string {
+	if u == Kilometers {
+		return fmt.Sprintf("%.0f m", u.FromFeet(feet))
+	}
+	return fmt.Sprintf("%.0f ft", feet)
+}
+
+// FormatPace formats minutes per mile as m:ss per this unit
+func (u DistanceUnit) FormatPace(minutesPerMile float64) string {
+	if minutesPerMile <= 0 {
+		return "-"
+	}
+	total := int(math.Round(minutesPerMile / u.FromMiles(1) * 60))
+	return fmt.Sprintf("%d:%02d /%s", total/60, total%60, u)
+}
+
+// speedRange is the plausible average speed in mph for an activity
+type speedRange struct {
+	Min, Max float64
+}
+
+// plausibleSpeeds flags entries that are almost certainly typos, such as a
+// 3-minute mile jog
+var plausibleSpeeds = map[ActivityType]speedRange{
+	Jogging:      {Min: 3, Max: 15},
+	Walking:      {Min: 1, Max: 5.5},
+	Cycling:      {Min: 4, Max: 35},
+	MountainBike: {Min: 2, Max: 25},
+	Skiing:       {Min: 2, Max: 60},
+}
+
+// Speed returns the average speed in mph, or 0 without a distance
+func (e ExerciseRecord) Speed() float64 {
+	if e.Distance <= 0 || e.Duration <= 0 {
+		return 0
+	}
+	return e.Distance / (float64(e.Duration) / 60)
+}
+
+// Pace returns the average minutes per mile, or 0 without a distance
+func (e ExerciseRecord) Pace() float64 {
+	if e.Distance <= 0 {
+		return 0
+	}
+	return float64(e.Duration) / e.Distance
+}
+
+// CheckPace reports a pace outside the plausible range for the activity.
+// Activities without a known range are not checked.
+func (e ExerciseRecord) CheckPace() error {
+	limits, ok := plausibleSpeeds[e.Activity]
+	if !ok || e.Distance <= 0 {
+		return nil
+	}
+	speed := e.Speed()
+	switch {
+	case speed > limits.Max:
+		return fmt.Errorf("%s pace of %s is faster than expected (over %.0f mph)",
+			e.Activity, FormatPace(e.Pace()), limits.Max)
+	case speed < limits.Min:
+		return fmt.Errorf("%s pace of %s is slower than expected (under %.0f mph)",
+			e.Activity, FormatPace(e.Pace()), limits.Min)
+	}
+	return nil
+}
+
+// FormatPace formats minutes per mile as m:ss /mi
+func FormatPace(minutesPerMile float64) string {
+	return Miles.FormatPace(minutesPerMile)
+}
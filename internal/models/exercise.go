@@ -3,46 +3,58 @@ package models
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
+// ActivityType is the name of an entry in the activity catalog
 type ActivityType string
 
+// Activities in the default catalog
 const (
-	Jogging      ActivityType = "jogging"
-	Skiing       ActivityType = "skiing"
-	Walking      ActivityType = "walking"
-	Cycling      ActivityType = "cycling"
-	MountainBike ActivityType = "mountain_biking"
-	Pickleball   ActivityType = "pickleball"
-	Other        ActivityType = "other"
+	Jogging       ActivityType = "jogging"
+	Skiing        ActivityType = "skiing"
+	Walking       ActivityType = "walking"
+	Cycling       ActivityType = "cycling"
+	MountainBike  ActivityType = "mountain_biking"
+	Pickleball    ActivityType = "pickleball"
+	WeightLifting ActivityType = "weight_lifting"
+
+	// Other was used with a free-text OtherActivity before the catalog existed.
+	// Such records are moved onto catalog entries when storage is initialized.
+	Other ActivityType = "other"
 )
 
 type ExerciseRecord struct {
-	Date          time.Time    `json:"date"`
-	Activity      ActivityType `json:"activity"`
-	OtherActivity string       `json:"other_activity,omitempty"` // name of activity if Activity is Other
-	Duration      int          `json:"duration"`                 // in minutes
-	Notes         string       `json:"notes,omitempty"`
-	Completed     bool         `json:"completed"`
+	ID            string        `json:"id"`
+	Date          time.Time     `json:"date"`
+	Activity      ActivityType  `json:"activity"`
+	OtherActivity string        `json:"other_activity,omitempty"` // legacy free-text name when Activity is Other
+	Duration      int           `json:"duration"`                 // in minutes
+	Intensity     Intensity     `json:"intensity,omitempty"`
+	RPE           int           `json:"rpe,omitempty"`            // rate of perceived exertion, 1-10
+	Distance      float64       `json:"distance,omitempty"`       // in miles
+	ElevationGain float64       `json:"elevation_gain,omitempty"` // in feet
+	Notes         string        `json:"notes,omitempty"`
+	Completed     bool          `json:"completed"`
+	Lifts         []Lift        `json:"lifts,omitempty"`   // exercises performed in a strength session
+	Plan          *ExercisePlan `json:"plan,omitempty"`    // set when the session was planned ahead
+	Source        *ImportSource `json:"source,omitempty"`  // set when imported from a workout file
+	GearID        string        `json:"gear_id,omitempty"` // the gear used, such as shoes or a bike
 }
 
-func (e ExerciseRecord) Validate() error {
-	// Validate activity type
-	validActivity := false
-	for _, a := range []ActivityType{Jogging, Skiing, Walking, Cycling, MountainBike, Pickleball, Other} {
-		if e.Activity == a {
-			validActivity = true
-			break
-		}
-	}
-	if !validActivity {
-		return fmt.Errorf("invalid activity type: %s", e.Activity)
-	}
+// ImportSource identifies the workout file a record was imported from
+type ImportSource struct {
+	File  string    `json:"file"`
+	Hash  string    `json:"hash"` // SHA-256 of the file, used to detect re-imports
+	Start time.Time `json:"start"`
+}
 
-	// If activity is Other, ensure OtherActivity is provided
-	if e.Activity == Other && e.OtherActivity == "" {
-		return fmt.Errorf("other_activity must be specified when activity type is 'other'")
+// Validate checks the record's own fields. Whether the activity is in the
+// catalog is checked against the user's settings when the record is entered.
+func (e ExerciseRecord) Validate() error {
+	if e.Activity == "" {
+		return fmt.Errorf("activity is required")
 	}
 
 	if e.Duration <= 0 {
@@ -52,13 +64,96 @@ func (e ExerciseRecord) Validate() error {
 		return fmt.Errorf("duration seems unreasonably high")
 	}
 
+	if e.RPE != 0 && (e.RPE < 1 || e.RPE > 10) {
+		return fmt.Errorf("RPE must be between 1 and 10")
+	}
+
+	if e.Distance < 0 || e.ElevationGain < 0 {
+		return fmt.Errorf("distance and elevation gain cannot be negative")
+	}
+	if e.Distance > 200 {
+		return fmt.Errorf("distance seems unreasonably high")
+	}
+	if e.ElevationGain > 0 && e.Distance == 0 {
+		return fmt.Errorf("elevation gain requires a distance")
+	}
+
+	for _, lift := range e.Lifts {
+		if err := lift.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (e ExerciseRecord) IsCompliant() bool {
-	return e.Completed && e.Duration >= 45 // 45 minutes daily goal
+// IntensityLevel returns the session's effort, from RPE when given, then the
+// declared intensity, defaulting to moderate
+func (e ExerciseRecord) IntensityLevel() Intensity {
+	switch {
+	case e.RPE >= 7:
+		return IntensityVigorous
+	case e.RPE >= 5:
+		return IntensityModerate
+	case e.RPE >= 1:
+		return IntensityLight
+	case e.Intensity != "":
+		return e.Intensity
+	default:
+		return IntensityModerate
+	}
+}
+
+// IsCompliant reports whether this session alone meets the daily goal in force
+// on its date. Days are judged on their total with ExerciseGoal.MeetsDaily.
+func (e ExerciseRecord) IsCompliant(goals ExerciseGoals) bool {
+	goal := goals.GoalFor(e.Date)
+	return goal.Counts(e) && e.Duration >= goal.DailyMinutes
 }
 
 func (e ExerciseRecord) GetDate() time.Time {
 	return e.Date
 }
+
+func (e ExerciseRecord) GetID() string {
+	return e.ID
+}
+
+// ExerciseDay totals the sessions logged on one date
+type ExerciseDay struct {
+	Date             time.Time
+	Sessions         int
+	TotalMinutes     int
+	CompletedMinutes int
+	Missed           int // planned sessions whose date passed without being done
+}
+
+// GroupExerciseByDay totals sessions per calendar day, in date order. Planned
+// sessions that haven't happened add no minutes.
+func GroupExerciseByDay(records []ExerciseRecord) []ExerciseDay {
+	now := time.Now()
+	index := make(map[time.Time]int)
+	var days []ExerciseDay
+	for _, record := range records {
+		day := DateOnly(record.Date)
+		i, ok := index[day]
+		if !ok {
+			i = len(days)
+			index[day] = i
+			days = append(days, ExerciseDay{Date: day})
+		}
+		if record.IsPlanned() && !record.Completed {
+			if record.IsMissed(now) {
+				days[i].Missed++
+			}
+			continue
+		}
+		days[i].Sessions++
+		days[i].TotalMinutes += record.Duration
+		if record.Completed {
+			days[i].CompletedMinutes += record.Duration
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	return days
+}
@@ -0,0 +1,220 @@
+// internal/models/exercise_goal.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ExerciseGoal holds the exercise targets in force from its EffectiveFrom date.
+// A zero target is not tracked.
+type ExerciseGoal struct {
+	EffectiveFrom   time.Time            `json:"effective_from"`
+	DailyMinutes    int                  `json:"daily_minutes"`
+	WeeklyMinutes   int                  `json:"weekly_minutes,omitempty"`
+	WeeklyIntensity Intensity            `json:"weekly_intensity,omitempty"` // least effort counted toward weekly minutes
+	SessionsPerWeek int                  `json:"sessions_per_week,omitempty"`
+	ActivityTargets map[ActivityType]int `json:"activity_targets,omitempty"` // weekly minutes per activity
+	CountIncomplete bool                 `json:"count_incomplete,omitempty"` // count sessions not marked completed
+}
+
+// ExerciseGoals is the history of goals, each in force from its EffectiveFrom date
+type ExerciseGoals []ExerciseGoal
+
+// DefaultExerciseGoal is the goal used before any goal has been configured
+func DefaultExerciseGoal() ExerciseGoal {
+	return ExerciseGoal{DailyMinutes: 45}
+}
+
+func (g ExerciseGoal) Validate() error {
+	if g.DailyMinutes < 0 || g.WeeklyMinutes < 0 || g.SessionsPerWeek < 0 {
+		return fmt.Errorf("goals cannot be negative")
+	}
+	if g.DailyMinutes > 480 {
+		return fmt.Errorf("daily goal seems unreasonably high")
+	}
+	if g.WeeklyMinutes > 7*480 {
+		return fmt.Errorf("weekly goal seems unreasonably high")
+	}
+	if g.SessionsPerWeek > 28 {
+		return fmt.Errorf("sessions per week seems unreasonably high")
+	}
+	if g.WeeklyIntensity != "" {
+		if _, err := ParseIntensity(string(g.WeeklyIntensity)); err != nil {
+			return err
+		}
+	}
+	for activity, minutes := range g.ActivityTargets {
+		if minutes <= 0 {
+			return fmt.Errorf("target for %s must be greater than 0", activity)
+		}
+		if minutes > 7*480 {
+			return fmt.Errorf("target for %s seems unreasonably high", activity)
+		}
+	}
+	return nil
+}
+
+// HasWeeklyTargets reports whether any weekly target is set
+func (g ExerciseGoal) HasWeeklyTargets() bool {
+	return g.WeeklyMinutes > 0 || g.SessionsPerWeek > 0 || len(g.ActivityTargets) > 0
+}
+
+// Counts reports whether a session counts toward the goal
+func (g ExerciseGoal) Counts(record ExerciseRecord) bool {
+	return record.Completed || g.CountIncomplete
+}
+
+// DayMinutes returns the minutes of a day that count toward the goal
+func (g ExerciseGoal) DayMinutes(day ExerciseDay) int {
+	if g.CountIncomplete {
+		return day.TotalMinutes
+	}
+	return day.CompletedMinutes
+}
+
+// MeetsDaily reports whether a day's counted minutes reach the daily goal.
+// A missed planned session fails the day whatever else was done.
+func (g ExerciseGoal) MeetsDaily(day ExerciseDay) bool {
+	return day.Missed == 0 && g.DayMinutes(day) >= g.DailyMinutes
+}
+
+// DailyStatus describes a day's progress toward the daily goal
+func (g ExerciseGoal) DailyStatus(day ExerciseDay) string {
+	if g.MeetsDaily(day) {
+		return "goal met"
+	}
+	if day.Missed > 0 {
+		return fmt.Sprintf("%d planned session(s) missed", day.Missed)
+	}
+	counted := "completed "
+	if g.CountIncomplete {
+		counted = ""
+	}
+	return fmt.Sprintf("%d more %sminutes needed for the %d minute goal",
+		g.DailyMinutes-g.DayMinutes(day), counted, g.DailyMinutes)
+}
+
+// GoalFor returns the goal in force on the given date
+func (g ExerciseGoals) GoalFor(date time.Time) ExerciseGoal {
+	current := DefaultExerciseGoal()
+	for _, goal := range g {
+		if !goal.EffectiveFrom.After(date) && !goal.EffectiveFrom.Before(current.EffectiveFrom) {
+			current = goal
+		}
+	}
+	return current
+}
+
+// Add records a new goal, replacing any goal with the same effective date
+func (g ExerciseGoals) Add(goal ExerciseGoal) ExerciseGoals {
+	updated := make(ExerciseGoals, 0, len(g)+1)
+	for _, existing := range g {
+		if !existing.EffectiveFrom.Equal(goal.EffectiveFrom) {
+			updated = append(updated, existing)
+		}
+	}
+	updated = append(updated, goal)
+
+	sort.Slice(updated, func(i, j int) bool {
+		return updated[i].EffectiveFrom.Before(updated[j].EffectiveFrom)
+	})
+	return updated
+}
+
+// RenameActivity moves per-activity targets from one activity name to another
+func (g ExerciseGoals) RenameActivity(from, to ActivityType) {
+	for _, goal := range g {
+		if minutes, ok := goal.ActivityTargets[from]; ok {
+			delete(goal.ActivityTargets, from)
+			goal.ActivityTargets[to] += minutes
+		}
+	}
+}
+
+// ExerciseWeek totals a Monday-Sunday week of sessions against the goal in
+// force at the start of that week
+type ExerciseWeek struct {
+	Start           time.Time
+	Goal            ExerciseGoal
+	Minutes         int // counted minutes at or above the goal's weekly intensity
+	Sessions        int
+	ActivityMinutes map[ActivityType]int
+	Missed          int // planned sessions whose date passed without being done
+}
+
+// IsCompliant reports whether the week meets every weekly target without
+// missing a planned session
+func (w ExerciseWeek) IsCompliant() bool {
+	if w.Missed > 0 || w.Minutes < w.Goal.WeeklyMinutes || w.Sessions < w.Goal.SessionsPerWeek {
+		return false
+	}
+	for activity, target := range w.Goal.ActivityTargets {
+		if w.ActivityMinutes[activity] < target {
+			return false
+		}
+	}
+	return true
+}
+
+// GroupExerciseByWeek totals counted sessions per Monday-Sunday week, in date
+// order. Every week from the one containing from to the one containing to is
+// included, so a week without sessions is still judged against its goal.
+func GroupExerciseByWeek(records []ExerciseRecord, goals ExerciseGoals, from, to time.Time) []ExerciseWeek {
+	now := time.Now()
+	index := make(map[time.Time]int)
+	var weeks []ExerciseWeek
+	addWeek := func(start time.Time) int {
+		if i, ok := index[start]; ok {
+			return i
+		}
+		index[start] = len(weeks)
+		weeks = append(weeks, ExerciseWeek{
+			Start:           start,
+			Goal:            goals.GoalFor(start),
+			ActivityMinutes: make(map[ActivityType]int),
+		})
+		return len(weeks) - 1
+	}
+	for start := WeekStart(from); !start.After(WeekStart(to)); start = start.AddDate(0, 0, 7) {
+		addWeek(start)
+	}
+
+	for _, record := range records {
+		i := addWeek(WeekStart(record.Date))
+
+		week := &weeks[i]
+		if record.IsMissed(now) {
+			week.Missed++
+			continue
+		}
+		if record.IsPlanned() && !record.Completed {
+			continue
+		}
+		if !week.Goal.Counts(record) {
+			continue
+		}
+		week.Sessions++
+		week.ActivityMinutes[record.Activity] += record.Duration
+		if intensityRank(record.IntensityLevel()) >= intensityRank(week.Goal.WeeklyIntensity) {
+			week.Minutes += record.Duration
+		}
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Start.Before(weeks[j].Start) })
+	return weeks
+}
+
+// intensityRank orders intensities from least to most effort, with no
+// intensity ranking lowest
+func intensityRank(intensity Intensity) int {
+	switch intensity {
+	case IntensityLight:
+		return 1
+	case IntensityModerate:
+		return 2
+	case IntensityVigorous:
+		return 3
+	}
+	return 0
+}
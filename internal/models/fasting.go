@@ -6,17 +6,48 @@ import (
 	"time"
 )
 
+// PatternSource records how a day's actual pattern was determined
+type PatternSource string
+
+const (
+	SourceManual PatternSource = "manual" // declared by the user
+	SourceTimer  PatternSource = "timer"  // derived from fasting windows
+	SourceMeals  PatternSource = "meals"  // derived from logged meals
+)
+
 type FastingRecord struct {
-	Date            time.Time   `json:"date"`
-	ExpectedPattern MealPattern `json:"expected_pattern"`
-	ActualPattern   MealPattern `json:"actual_pattern"`
-	Notes           string      `json:"notes,omitempty"`
+	Date            time.Time     `json:"date"`
+	ExpectedPattern MealPattern   `json:"expected_pattern"`
+	ActualPattern   MealPattern   `json:"actual_pattern"`
+	Source          PatternSource `json:"source,omitempty"`        // empty for records logged before sources existed
+	EatingWindow    float64       `json:"eating_window,omitempty"` // in hours, when derived from meals
+	Notes           string        `json:"notes,omitempty"`
 }
 
 func (f FastingRecord) GetDate() time.Time {
 	return f.Date
 }
 
+// IsDerived reports whether the actual pattern was worked out automatically
+// rather than declared, so it may be recalculated
+func (f FastingRecord) IsDerived() bool {
+	return f.Source != "" && f.Source != SourceManual
+}
+
+// CanDeriveFrom reports whether a pattern derived from source may replace this
+// record's pattern. Declared patterns always win, and logged meals are a more
+// direct measure than fasting windows.
+func (f FastingRecord) CanDeriveFrom(source PatternSource) bool {
+	switch {
+	case !f.IsDerived():
+		return false
+	case source == SourceTimer:
+		return f.Source == SourceTimer
+	default:
+		return true
+	}
+}
+
 func (f FastingRecord) Validate() error {
 	switch f.ActualPattern {
 	case FullFast, OneMeal, Regular:
@@ -27,15 +58,12 @@ func (f FastingRecord) Validate() error {
 	return nil
 }
 
+// IsCompliant compares the actual pattern against the pattern expected by the
+// schedule in force when the record was logged
 func (f FastingRecord) IsCompliant() bool {
-	weekday := f.Date.Weekday()
-	switch weekday {
-	case time.Monday, time.Tuesday:
-		return f.ActualPattern == FullFast
-	case time.Wednesday, time.Thursday:
-		return f.ActualPattern == OneMeal
-	case time.Friday, time.Saturday, time.Sunday:
-		return f.ActualPattern == Regular
+	expected := f.ExpectedPattern
+	if expected == "" {
+		expected = DefaultFastingSchedule().Pattern(f.Date.Weekday())
 	}
-	return false
+	return f.ActualPattern == expected
 }
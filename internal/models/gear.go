@@ -0,0 +1,188 @@
+// internal/models/gear.go
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Gear is a piece of equipment, such as running shoes or a bike, whose usage
+// accumulates from the exercise sessions linked to it
+type Gear struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	Activity     ActivityType `json:"activity"`
+	Default      bool         `json:"default,omitempty"`       // linked to new sessions of the activity
+	InitialMiles float64      `json:"initial_miles,omitempty"` // usage from before it was tracked
+	InitialHours float64      `json:"initial_hours,omitempty"`
+	RetireMiles  float64      `json:"retire_miles,omitempty"` // retirement threshold, 0 for none
+	RetireHours  float64      `json:"retire_hours,omitempty"`
+	Added        time.Time    `json:"added"`
+	Retired      bool         `json:"retired,omitempty"`
+}
+
+func (g Gear) GetID() string {
+	return g.ID
+}
+
+// Validate checks the gear's own fields
+func (g Gear) Validate() error {
+	if strings.TrimSpace(g.Name) == "" {
+		return fmt.Errorf("gear name is required")
+	}
+	if g.Activity == "" {
+		return fmt.Errorf("activity is required")
+	}
+	if g.InitialMiles < 0 || g.InitialHours < 0 || g.RetireMiles < 0 || g.RetireHours < 0 {
+		return fmt.Errorf("usage and retirement thresholds cannot be negative")
+	}
+	return nil
+}
+
+// ParseGearAmount reads a distance or time such as "400mi", "650km" or "120h"
+// and returns it as miles or hours
+func ParseGearAmount(value string) (miles, hours float64, err error) {
+	text := strings.ToLower(strings.TrimSpace(value))
+	number := strings.TrimRightFunc(text, func(r rune) bool { return r >= 'a' && r <= 'z' })
+	unit := strings.TrimSpace(text[len(number):])
+
+	amount, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil || amount < 0 {
+		return 0, 0, fmt.Errorf("invalid amount: %s (use a distance such as 400mi or 650km, or hours such as 120h)", value)
+	}
+
+	switch unit {
+	case "h", "hr", "hrs", "hour", "hours":
+		return 0, amount, nil
+	case "":
+		return 0, 0, fmt.Errorf("amount %s needs a unit: mi, km or h", value)
+	}
+	distanceUnit, err := ParseDistanceUnit(unit)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid amount: %s (use mi, km or h)", value)
+	}
+	return distanceUnit.ToMiles(amount), 0, nil
+}
+
+// FormatRetireAt describes the gear's retirement threshold
+func (g Gear) FormatRetireAt() string {
+	var limits []string
+	if g.RetireMiles > 0 {
+		limits = append(limits, fmt.Sprintf("%.0f mi", g.RetireMiles))
+	}
+	if g.RetireHours > 0 {
+		limits = append(limits, fmt.Sprintf("%.0f h", g.RetireHours))
+	}
+	if len(limits) == 0 {
+		return "-"
+	}
+	return strings.Join(limits, " or ")
+}
+
+// GearList is the user's gear, active and retired
+type GearList []Gear
+
+// Find looks gear up by ID or name, ignoring case. A name shared with retired
+// gear finds the active gear; a name that still matches several is an error.
+func (l GearList) Find(ref string) (*Gear, error) {
+	for i := range l {
+		if l[i].ID == ref {
+			return &l[i], nil
+		}
+	}
+
+	var active, retired []*Gear
+	for i := range l {
+		if !strings.EqualFold(l[i].Name, strings.TrimSpace(ref)) {
+			continue
+		}
+		if l[i].Retired {
+			retired = append(retired, &l[i])
+		} else {
+			active = append(active, &l[i])
+		}
+	}
+	matches := active
+	if len(matches) == 0 {
+		matches = retired
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, gear := range matches {
+		ids[i] = gear.ID
+	}
+	return nil, fmt.Errorf("several gear named %s: use an ID (%s)", strings.TrimSpace(ref), strings.Join(ids, ", "))
+}
+
+// DefaultFor returns the active default gear for an activity, or nil
+func (l GearList) DefaultFor(activity ActivityType) *Gear {
+	for i := range l {
+		if l[i].Activity == activity && l[i].Default && !l[i].Retired {
+			return &l[i]
+		}
+	}
+	return nil
+}
+
+// GearUsage totals the sessions linked to a piece of gear
+type GearUsage struct {
+	Gear     Gear
+	Sessions int
+	Distance float64 // miles across linked sessions
+	Minutes  int
+}
+
+// ComputeGearUsage totals usage for gear from its linked sessions. Planned
+// sessions that haven't been done add nothing.
+func ComputeGearUsage(gear Gear, records []ExerciseRecord) GearUsage {
+	usage := GearUsage{Gear: gear}
+	for _, record := range records {
+		if record.GearID != gear.ID || record.IsOutstanding() {
+			continue
+		}
+		usage.Sessions++
+		usage.Distance += record.Distance
+		usage.Minutes += record.Duration
+	}
+	return usage
+}
+
+// Miles returns the total distance including usage from before tracking
+func (u GearUsage) Miles() float64 {
+	return u.Gear.InitialMiles + u.Distance
+}
+
+// Hours returns the total time used including usage from before tracking
+func (u GearUsage) Hours() float64 {
+	return u.Gear.InitialHours + float64(u.Minutes)/60
+}
+
+// PastRetirement reports whether usage has reached either retirement threshold
+func (u GearUsage) PastRetirement() bool {
+	return (u.Gear.RetireMiles > 0 && u.Miles() >= u.Gear.RetireMiles) ||
+		(u.Gear.RetireHours > 0 && u.Hours() >= u.Gear.RetireHours)
+}
+
+// String describes the usage for success messages
+func (u GearUsage) String() string {
+	return fmt.Sprintf("%s: %.1f mi, %.1f h over %d session(s) (retire at %s)",
+		u.Gear.Name, u.Miles(), u.Hours(), u.Sessions, u.Gear.FormatRetireAt())
+}
+
+// RetirementWarning describes active gear past its retirement threshold, or
+// returns "" when it is within it
+func (u GearUsage) RetirementWarning() string {
+	if u.Gear.Retired || !u.PastRetirement() {
+		return ""
+	}
+	return fmt.Sprintf("%s has passed its retirement threshold of %s with %.1f mi and %.1f h (retire it with 'tracker gear retire %s')",
+		u.Gear.Name, u.Gear.FormatRetireAt(), u.Miles(), u.Hours(), u.Gear.ID)
+}
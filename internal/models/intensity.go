@@ -0,0 +1,57 @@
+// internal/models/intensity.go
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Intensity is how hard an exercise session was
+type Intensity string
+
+const (
+	IntensityLight    Intensity = "light"
+	IntensityModerate Intensity = "moderate"
+	IntensityVigorous Intensity = "vigorous"
+)
+
+// ParseIntensity converts a command line value into an Intensity
+func ParseIntensity(intensity string) (Intensity, error) {
+	switch i := Intensity(strings.ToLower(intensity)); i {
+	case IntensityLight, IntensityModerate, IntensityVigorous:
+		return i, nil
+	}
+	return "", fmt.Errorf("invalid intensity: %s (use light, moderate or vigorous)", intensity)
+}
+
+// METs holds an activity's metabolic equivalents at each intensity
+type METs struct {
+	Light    float64 `json:"light"`
+	Moderate float64 `json:"moderate"`
+	Vigorous float64 `json:"vigorous"`
+}
+
+// For returns the MET value for an intensity
+func (m METs) For(intensity Intensity) float64 {
+	switch intensity {
+	case IntensityLight:
+		return m.Light
+	case IntensityVigorous:
+		return m.Vigorous
+	default:
+		return m.Moderate
+	}
+}
+
+func (m METs) Validate() error {
+	if m.Light <= 0 || m.Moderate <= 0 || m.Vigorous <= 0 {
+		return fmt.Errorf("MET values must be greater than 0")
+	}
+	if m.Light > m.Moderate || m.Moderate > m.Vigorous {
+		return fmt.Errorf("MET values must increase from light to moderate to vigorous")
+	}
+	if m.Vigorous > 25 {
+		return fmt.Errorf("MET values seem unreasonably high")
+	}
+	return nil
+}
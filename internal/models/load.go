@@ -0,0 +1,138 @@
+// internal/models/load.go
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// LoadZone classifies an acute:chronic workload ratio
+type LoadZone string
+
+const (
+	LoadUnknown  LoadZone = "unknown"
+	LoadLow      LoadZone = "undertraining"
+	LoadOptimal  LoadZone = "optimal"
+	LoadCaution  LoadZone = "caution"
+	LoadHighRisk LoadZone = "high risk"
+)
+
+// Ratio boundaries between load zones, the monotony above which a week lacks
+// enough easy days, and the days of history the ratio needs to be reliable
+const (
+	LowRatio       = 0.8
+	CautionRatio   = 1.3
+	HighRiskRatio  = 1.5
+	HighMonotony   = 2.0
+	MinLoadHistory = 21
+
+	maxMonotony = 10.0
+)
+
+// sessionRPEs stands in for RPE when a session only has an intensity level
+var sessionRPEs = map[Intensity]int{
+	IntensityLight:    3,
+	IntensityModerate: 5,
+	IntensityVigorous: 8,
+}
+
+// SessionRPE returns the session's RPE, estimated from the intensity level
+// when none was given
+func (e ExerciseRecord) SessionRPE() int {
+	if e.RPE > 0 {
+		return e.RPE
+	}
+	return sessionRPEs[e.IntensityLevel()]
+}
+
+// Load returns the session's training load in arbitrary units: duration
+// times session RPE
+func (e ExerciseRecord) Load() float64 {
+	return float64(e.Duration * e.SessionRPE())
+}
+
+// TrainingLoad summarizes training load for the periods ending on AsOf
+type TrainingLoad struct {
+	AsOf     time.Time
+	Acute    float64 // load over the last 7 days
+	Chronic  float64 // average weekly load over the last 28 days
+	Ratio    float64 // acute:chronic workload ratio, 0 without chronic load
+	Monotony float64 // mean daily load over stdev for the last 7 days
+	Strain   float64 // acute load times monotony
+	Days     int     // days of history, up to the 28 day window
+}
+
+// ComputeTrainingLoad works out training load as of a date from completed
+// sessions. Sessions are counted by calendar day, so those logged with a time
+// of day on asOf are included.
+func ComputeTrainingLoad(records []ExerciseRecord, asOf time.Time) TrainingLoad {
+	asOf = DateOnly(asOf)
+	windowStart := asOf.AddDate(0, 0, -27)
+
+	// Daily loads, index 0 being 27 days before asOf
+	var daily [28]float64
+	earliest := asOf.AddDate(0, 0, 1)
+	for _, record := range records {
+		day := DateOnly(record.Date)
+		if !record.Completed || day.After(asOf) {
+			continue
+		}
+		if day.Before(earliest) {
+			earliest = day
+		}
+		if !day.Before(windowStart) {
+			daily[int(day.Sub(windowStart).Hours()/24)] += record.Load()
+		}
+	}
+
+	load := TrainingLoad{AsOf: asOf}
+	if earliest.After(asOf) {
+		return load
+	}
+	load.Days = min(int(asOf.Sub(earliest).Hours()/24)+1, 28)
+
+	total := 0.0
+	for i, value := range daily {
+		total += value
+		if i >= 21 {
+			load.Acute += value
+		}
+	}
+	load.Chronic = total / 4
+	if load.Chronic > 0 {
+		load.Ratio = load.Acute / load.Chronic
+	}
+
+	mean := load.Acute / 7
+	variance := 0.0
+	for _, value := range daily[21:] {
+		variance += (value - mean) * (value - mean)
+	}
+	stdev := math.Sqrt(variance / 7)
+	switch {
+	case stdev > 0:
+		load.Monotony = min(mean/stdev, maxMonotony)
+	case mean > 0:
+		load.Monotony = maxMonotony // the same load every day
+	}
+	load.Strain = load.Acute * load.Monotony
+
+	return load
+}
+
+// Zone classifies the acute:chronic ratio. Less than three weeks of history
+// gives no reliable chronic baseline.
+func (t TrainingLoad) Zone() LoadZone {
+	switch {
+	case t.Chronic == 0 || t.Days < MinLoadHistory:
+		return LoadUnknown
+	case t.Ratio > HighRiskRatio:
+		return LoadHighRisk
+	case t.Ratio > CautionRatio:
+		return LoadCaution
+	case t.Ratio < LowRatio:
+		return LoadLow
+	default:
+		return LoadOptimal
+	}
+}
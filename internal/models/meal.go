@@ -0,0 +1,45 @@
+// internal/models/meal.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MealEntry is a single logged meal
+type MealEntry struct {
+	Time  time.Time `json:"time"`
+	Notes string    `json:"notes,omitempty"`
+}
+
+func (m MealEntry) GetDate() time.Time {
+	return m.Time
+}
+
+func (m MealEntry) Validate() error {
+	if m.Time.IsZero() {
+		return fmt.Errorf("meal time is required")
+	}
+	return nil
+}
+
+// DeriveFromMeals works out a day's meal pattern from its logged meals:
+// no meals is a full fast, one meal is one-meal and anything more is regular.
+// The eating window runs from the first meal to the last.
+func DeriveFromMeals(meals []MealEntry) (MealPattern, time.Duration) {
+	switch len(meals) {
+	case 0:
+		return FullFast, 0
+	case 1:
+		return OneMeal, 0
+	}
+
+	times := make([]time.Time, len(meals))
+	for i, meal := range meals {
+		times[i] = meal.Time
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	return Regular, times[len(times)-1].Sub(times[0])
+}
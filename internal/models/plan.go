@@ -0,0 +1,94 @@
+// internal/models/plan.go
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// ExercisePlan holds what was planned for a session scheduled ahead of time.
+// The record's own fields hold the actual session once it is done.
+type ExercisePlan struct {
+	Duration int     `json:"duration"`           // planned minutes
+	Distance float64 `json:"distance,omitempty"` // planned miles
+}
+
+// IsPlanned reports whether the session was scheduled ahead of time
+func (e ExerciseRecord) IsPlanned() bool {
+	return e.Plan != nil
+}
+
+// IsOutstanding reports whether a planned session hasn't been done, so its
+// duration and distance are only planned
+func (e ExerciseRecord) IsOutstanding() bool {
+	return e.IsPlanned() && !e.Completed
+}
+
+// IsPending reports whether a planned session is still to come
+func (e ExerciseRecord) IsPending(today time.Time) bool {
+	return e.IsOutstanding() && !e.Date.Before(CalendarDate(today))
+}
+
+// IsMissed reports whether a planned session's date has passed without it being done
+func (e ExerciseRecord) IsMissed(today time.Time) bool {
+	return e.IsOutstanding() && e.Date.Before(CalendarDate(today))
+}
+
+// PlanWeek compares a Monday-Sunday week's planned sessions with what was done
+type PlanWeek struct {
+	Start          time.Time
+	Planned        int // sessions planned
+	Done           int // planned sessions completed
+	Missed         int
+	Pending        int
+	Unplanned      int // completed sessions that weren't planned
+	PlannedMinutes int // minutes planned for sessions that are done or missed
+	ActualMinutes  int // minutes of planned sessions that were done
+}
+
+// Adherence returns the share of due planned sessions that were done, or -1
+// when none are due yet
+func (w PlanWeek) Adherence() float64 {
+	due := w.Done + w.Missed
+	if due == 0 {
+		return -1
+	}
+	return float64(w.Done) / float64(due)
+}
+
+// GroupPlansByWeek compares planned and actual sessions per week, in date order
+func GroupPlansByWeek(records []ExerciseRecord, today time.Time) []PlanWeek {
+	index := make(map[time.Time]int)
+	var weeks []PlanWeek
+	for _, record := range records {
+		start := WeekStart(record.Date)
+		i, ok := index[start]
+		if !ok {
+			i = len(weeks)
+			index[start] = i
+			weeks = append(weeks, PlanWeek{Start: start})
+		}
+
+		week := &weeks[i]
+		switch {
+		case !record.IsPlanned():
+			if record.Completed {
+				week.Unplanned++
+			}
+		case record.Completed:
+			week.Planned++
+			week.Done++
+			week.PlannedMinutes += record.Plan.Duration
+			week.ActualMinutes += record.Duration
+		case record.IsMissed(today):
+			week.Planned++
+			week.Missed++
+			week.PlannedMinutes += record.Plan.Duration
+		default:
+			week.Planned++
+			week.Pending++
+		}
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Start.Before(weeks[j].Start) })
+	return weeks
+}
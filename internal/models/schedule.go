@@ -0,0 +1,158 @@
+// internal/models/schedule.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FastingSchedule maps each weekday to the meal pattern expected on that day
+type FastingSchedule struct {
+	EffectiveFrom time.Time              `json:"effective_from"`
+	Name          string                 `json:"name,omitempty"`
+	Days          map[string]MealPattern `json:"days"` // keyed by lowercase weekday name
+}
+
+// FastingSchedules is the history of schedules, each in force from its EffectiveFrom date
+type FastingSchedules []FastingSchedule
+
+// Schedule presets for common fasting protocols
+var schedulePresets = map[string]map[time.Weekday]MealPattern{
+	"default": {
+		time.Monday: FullFast, time.Tuesday: FullFast,
+		time.Wednesday: OneMeal, time.Thursday: OneMeal,
+		time.Friday: Regular, time.Saturday: Regular, time.Sunday: Regular,
+	},
+	"5:2": {
+		time.Monday: FullFast, time.Thursday: FullFast,
+		time.Tuesday: Regular, time.Wednesday: Regular,
+		time.Friday: Regular, time.Saturday: Regular, time.Sunday: Regular,
+	},
+	"omad": {
+		time.Monday: OneMeal, time.Tuesday: OneMeal, time.Wednesday: OneMeal,
+		time.Thursday: OneMeal, time.Friday: OneMeal, time.Saturday: OneMeal,
+		time.Sunday: OneMeal,
+	},
+	// A weekly approximation of alternate-day fasting
+	"alternate-day": {
+		time.Monday: FullFast, time.Wednesday: FullFast, time.Friday: FullFast,
+		time.Tuesday: Regular, time.Thursday: Regular,
+		time.Saturday: Regular, time.Sunday: Regular,
+	},
+}
+
+// SchedulePresetNames returns the names of the built-in schedule presets
+func SchedulePresetNames() []string {
+	names := make([]string, 0, len(schedulePresets))
+	for name := range schedulePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewSchedulePreset builds a schedule from one of the built-in presets
+func NewSchedulePreset(name string, effectiveFrom time.Time) (FastingSchedule, error) {
+	preset, ok := schedulePresets[name]
+	if !ok {
+		return FastingSchedule{}, fmt.Errorf("unknown schedule preset: %s (use %s)",
+			name, strings.Join(SchedulePresetNames(), ", "))
+	}
+
+	schedule := FastingSchedule{
+		EffectiveFrom: effectiveFrom,
+		Name:          name,
+		Days:          make(map[string]MealPattern),
+	}
+	for weekday, pattern := range preset {
+		schedule.SetPattern(weekday, pattern)
+	}
+	return schedule, nil
+}
+
+// DefaultFastingSchedule is the schedule used before any schedule has been configured
+func DefaultFastingSchedule() FastingSchedule {
+	schedule, _ := NewSchedulePreset("default", time.Time{})
+	return schedule
+}
+
+// Pattern returns the meal pattern expected on the given weekday
+func (s FastingSchedule) Pattern(weekday time.Weekday) MealPattern {
+	if pattern, ok := s.Days[weekdayKey(weekday)]; ok {
+		return pattern
+	}
+	return Regular
+}
+
+// SetPattern sets the meal pattern expected on the given weekday
+func (s *FastingSchedule) SetPattern(weekday time.Weekday, pattern MealPattern) {
+	if s.Days == nil {
+		s.Days = make(map[string]MealPattern)
+	}
+	s.Days[weekdayKey(weekday)] = pattern
+}
+
+func (s FastingSchedule) Validate() error {
+	for day, pattern := range s.Days {
+		if _, err := ParseWeekday(day); err != nil {
+			return err
+		}
+		switch pattern {
+		case FullFast, OneMeal, Regular:
+			// valid pattern
+		default:
+			return fmt.Errorf("invalid meal pattern for %s: %s", day, pattern)
+		}
+	}
+	return nil
+}
+
+// ScheduleFor returns the schedule in force on the given date
+func (s FastingSchedules) ScheduleFor(date time.Time) FastingSchedule {
+	current := DefaultFastingSchedule()
+	for _, schedule := range s {
+		if !schedule.EffectiveFrom.After(date) && !schedule.EffectiveFrom.Before(current.EffectiveFrom) {
+			current = schedule
+		}
+	}
+	return current
+}
+
+// ExpectedPattern returns the meal pattern expected on the given date
+func (s FastingSchedules) ExpectedPattern(date time.Time) MealPattern {
+	return s.ScheduleFor(date).Pattern(date.Weekday())
+}
+
+// Add records a new schedule, replacing any schedule with the same effective date
+func (s FastingSchedules) Add(schedule FastingSchedule) FastingSchedules {
+	updated := make(FastingSchedules, 0, len(s)+1)
+	for _, existing := range s {
+		if !existing.EffectiveFrom.Equal(schedule.EffectiveFrom) {
+			updated = append(updated, existing)
+		}
+	}
+	updated = append(updated, schedule)
+
+	sort.Slice(updated, func(i, j int) bool {
+		return updated[i].EffectiveFrom.Before(updated[j].EffectiveFrom)
+	})
+	return updated
+}
+
+// ParseWeekday converts a weekday name such as "monday" or "mon" to time.Weekday
+func ParseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(name)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday: %s", name)
+}
+
+func weekdayKey(weekday time.Weekday) string {
+	return strings.ToLower(weekday.String())
+}
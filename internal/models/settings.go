@@ -0,0 +1,56 @@
+// internal/models/settings.go
+package models
+
+// Settings holds user preferences persisted alongside the record files
+type Settings struct {
+	FastingSchedules FastingSchedules  `json:"fasting_schedules,omitempty"`
+	SodaAllowance    *SodaAllowance    `json:"soda_allowance,omitempty"`
+	Beverages        BeverageCatalog   `json:"beverages,omitempty"`
+	Activities       ActivityCatalog   `json:"activities,omitempty"`
+	ExerciseGoals    ExerciseGoals     `json:"exercise_goals,omitempty"`
+	WeightUnit       WeightUnit        `json:"weight_unit,omitempty"`
+	TrendSmoothing   float64           `json:"trend_smoothing,omitempty"`
+	WeightGoal       *WeightGoal       `json:"weight_goal,omitempty"`
+	DailyWeight      DailyWeightPolicy `json:"daily_weight,omitempty"`
+}
+
+// GetSodaAllowance returns the configured soda allowance or the default rules
+func (s Settings) GetSodaAllowance() SodaAllowance {
+	if s.SodaAllowance == nil {
+		return DefaultSodaAllowance()
+	}
+	return *s.SodaAllowance
+}
+
+// GetActivityCatalog returns the configured activities or the default catalog
+func (s Settings) GetActivityCatalog() ActivityCatalog {
+	if len(s.Activities) == 0 {
+		return DefaultActivityCatalog()
+	}
+	return s.Activities
+}
+
+// GetWeightUnit returns the preferred weight unit, pounds unless set
+func (s Settings) GetWeightUnit() WeightUnit {
+	if s.WeightUnit == "" {
+		return Pounds
+	}
+	return s.WeightUnit
+}
+
+// GetTrendSmoothing returns the weight trend smoothing factor or the default
+func (s Settings) GetTrendSmoothing() float64 {
+	if s.TrendSmoothing == 0 {
+		return DefaultTrendSmoothing
+	}
+	return s.TrendSmoothing
+}
+
+// GetDailyWeightPolicy returns how a day's weight is chosen from several
+// weigh-ins, the first of the day unless set
+func (s Settings) GetDailyWeightPolicy() DailyWeightPolicy {
+	if s.DailyWeight == "" {
+		return DailyFirst
+	}
+	return s.DailyWeight
+}
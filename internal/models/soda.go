@@ -10,6 +10,9 @@ type SodaRecord struct {
 	Date     time.Time `json:"date"`
 	Consumed bool      `json:"consumed"`
 	Quantity float64   `json:"quantity,omitempty"` // in oz
+	Caffeine float64   `json:"caffeine,omitempty"` // in mg, when derived from drink entries
+	Sugar    float64   `json:"sugar,omitempty"`    // in g, when derived from drink entries
+	Entries  int       `json:"entries,omitempty"`  // number of drink entries the day is derived from
 	Notes    string    `json:"notes,omitempty"`
 }
 
@@ -30,13 +33,26 @@ func (s SodaRecord) Validate() error {
 	return nil
 }
 
-func (s SodaRecord) IsCompliant() bool {
+// IsDerived reports whether the record was built from logged drinks rather
+// than entered by hand
+func (s SodaRecord) IsDerived() bool {
+	return s.Entries > 0
+}
+
+// IsWeekend reports whether the record falls in the Friday-Sunday allowance window
+func (s SodaRecord) IsWeekend() bool {
 	weekday := s.Date.Weekday()
-	switch {
-	case weekday >= time.Monday && weekday <= time.Thursday:
-		return !s.Consumed
-	case weekday >= time.Friday && weekday <= time.Sunday:
-		return !s.Consumed || s.Quantity <= 12.0
-	}
-	return false
+	return weekday == time.Friday || weekday == time.Saturday || weekday == time.Sunday
+}
+
+// IsCompliant checks the quantity against the allowance's cap for the day.
+// Weekly budgets are checked with SodaAllowance.OverBudget.
+func (s SodaRecord) IsCompliant(allowance SodaAllowance) bool {
+	return !s.Consumed || s.Quantity <= allowance.DailyCap(s.Date.Weekday())
+}
+
+// SodaWithAllowance pairs a record with the allowance it should be judged against
+type SodaWithAllowance struct {
+	Record    SodaRecord
+	Allowance SodaAllowance
 }
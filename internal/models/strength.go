@@ -0,0 +1,193 @@
+// internal/models/strength.go
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OneRepMaxFormula estimates a one-rep max from a set of several reps
+type OneRepMaxFormula string
+
+const (
+	Epley   OneRepMaxFormula = "epley"
+	Brzycki OneRepMaxFormula = "brzycki"
+)
+
+// ParseOneRepMaxFormula converts a command line value into a OneRepMaxFormula
+func ParseOneRepMaxFormula(formula string) (OneRepMaxFormula, error) {
+	switch f := OneRepMaxFormula(strings.ToLower(formula)); f {
+	case Epley, Brzycki:
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid 1RM formula: %s (use epley or brzycki)", formula)
+}
+
+// Estimate returns the estimated one-rep max for weight lifted for reps.
+// Both formulas lose accuracy beyond about ten reps.
+func (f OneRepMaxFormula) Estimate(weight float64, reps int) float64 {
+	switch {
+	case reps <= 0 || weight <= 0:
+		return 0
+	case reps == 1:
+		return weight
+	case f == Brzycki && reps < 37:
+		return weight * 36 / float64(37-reps)
+	default:
+		return weight * (1 + float64(reps)/30)
+	}
+}
+
+// LiftSet is one set of an exercise
+type LiftSet struct {
+	Reps   int     `json:"reps"`
+	Weight float64 `json:"weight"` // in pounds, 0 for bodyweight
+}
+
+// Lift is one exercise within a strength session, such as squat or bench press
+type Lift struct {
+	Name string    `json:"name"`
+	Sets []LiftSet `json:"sets"`
+}
+
+// NormalizeLiftName turns free text such as "Bench Press" into the stored
+// form "bench_press"
+func NormalizeLiftName(name string) string {
+	fields := strings.Fields(strings.ToLower(strings.ReplaceAll(name, "-", " ")))
+	return strings.Join(fields, "_")
+}
+
+func (l Lift) Validate() error {
+	if l.Name == "" {
+		return fmt.Errorf("lift name is required")
+	}
+	if len(l.Sets) == 0 {
+		return fmt.Errorf("%s needs at least one set", l.Name)
+	}
+	for _, set := range l.Sets {
+		if set.Reps <= 0 || set.Reps > 100 {
+			return fmt.Errorf("%s reps must be between 1 and 100", l.Name)
+		}
+		if set.Weight < 0 || set.Weight > 2000 {
+			return fmt.Errorf("%s weight must be between 0 and 2000 lbs", l.Name)
+		}
+	}
+	return nil
+}
+
+// Volume returns total reps times weight across all sets
+func (l Lift) Volume() float64 {
+	volume := 0.0
+	for _, set := range l.Sets {
+		volume += float64(set.Reps) * set.Weight
+	}
+	return volume
+}
+
+// Reps returns total reps across all sets
+func (l Lift) Reps() int {
+	reps := 0
+	for _, set := range l.Sets {
+		reps += set.Reps
+	}
+	return reps
+}
+
+// TopSet returns the set with the highest estimated one-rep max
+func (l Lift) TopSet(formula OneRepMaxFormula) LiftSet {
+	var top LiftSet
+	for i, set := range l.Sets {
+		if i == 0 || formula.Estimate(set.Weight, set.Reps) > formula.Estimate(top.Weight, top.Reps) {
+			top = set
+		}
+	}
+	return top
+}
+
+// OneRepMax returns the best estimated one-rep max across all sets
+func (l Lift) OneRepMax(formula OneRepMaxFormula) float64 {
+	top := l.TopSet(formula)
+	return formula.Estimate(top.Weight, top.Reps)
+}
+
+// FormatSets summarizes sets compactly, grouping repeats: "3x5@225, 1x3@245"
+func (l Lift) FormatSets() string {
+	var groups []string
+	for i := 0; i < len(l.Sets); {
+		j := i
+		for j < len(l.Sets) && l.Sets[j] == l.Sets[i] {
+			j++
+		}
+		groups = append(groups, fmt.Sprintf("%dx%s", j-i, formatSet(l.Sets[i])))
+		i = j
+	}
+	return strings.Join(groups, ", ")
+}
+
+func formatSet(set LiftSet) string {
+	if set.Weight == 0 {
+		return fmt.Sprintf("%d", set.Reps)
+	}
+	return fmt.Sprintf("%d@%s", set.Reps, strconv.FormatFloat(set.Weight, 'f', -1, 64))
+}
+
+// ParseLift reads a lift as NAME=SETS, where SETS is a comma separated list of
+// [COUNTx]REPS[@WEIGHT], for example "squat=3x5@225,1x3@245" or "pullup=3x8"
+func ParseLift(value string) (Lift, error) {
+	name, spec, ok := strings.Cut(value, "=")
+	lift := Lift{Name: NormalizeLiftName(name)}
+	if !ok || lift.Name == "" || strings.TrimSpace(spec) == "" {
+		return Lift{}, fmt.Errorf("invalid lift: %s (use NAME=SETSxREPS@WEIGHT, e.g. squat=3x5@225)", value)
+	}
+
+	for _, group := range strings.Split(spec, ",") {
+		group = strings.TrimSpace(group)
+		count := 1
+		if c, rest, found := strings.Cut(group, "x"); found {
+			n, err := strconv.Atoi(c)
+			if err != nil || n <= 0 || n > 50 {
+				return Lift{}, fmt.Errorf("invalid set count in %s: %s", lift.Name, group)
+			}
+			count, group = n, rest
+		}
+
+		repsText, weightText, hasWeight := strings.Cut(group, "@")
+		reps, err := strconv.Atoi(repsText)
+		if err != nil {
+			return Lift{}, fmt.Errorf("invalid reps in %s: %s", lift.Name, group)
+		}
+		weight := 0.0
+		if hasWeight {
+			if weight, err = strconv.ParseFloat(weightText, 64); err != nil {
+				return Lift{}, fmt.Errorf("invalid weight in %s: %s", lift.Name, group)
+			}
+		}
+
+		for i := 0; i < count; i++ {
+			lift.Sets = append(lift.Sets, LiftSet{Reps: reps, Weight: weight})
+		}
+	}
+
+	return lift, lift.Validate()
+}
+
+// LiftVolume returns the total volume of all lifts in a session
+func (e ExerciseRecord) LiftVolume() float64 {
+	volume := 0.0
+	for _, lift := range e.Lifts {
+		volume += lift.Volume()
+	}
+	return volume
+}
+
+// FindLift returns the session's entry for a lift, if it was performed
+func (e ExerciseRecord) FindLift(name string) *Lift {
+	key := NormalizeLiftName(name)
+	for i := range e.Lifts {
+		if e.Lifts[i].Name == key {
+			return &e.Lifts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// internal/models/trend.go
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// DefaultTrendSmoothing is the share of each day's difference from the trend
+// that moves the trend, as in The Hacker's Diet
+const DefaultTrendSmoothing = 0.1
+
+// ValidateTrendSmoothing checks a smoothing factor. Larger factors follow
+// the scale more closely.
+func ValidateTrendSmoothing(smoothing float64) error {
+	if smoothing <= 0 || smoothing > 1 {
+		return fmt.Errorf("smoothing factor must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// TrendPoint is a weigh-in with the smoothed trend weight on its date
+type TrendPoint struct {
+	RecordID string
+	Date     time.Time
+	Weight   float64 // in pounds
+	Trend    float64 // in pounds
+}
+
+// ComputeWeightTrend smooths weigh-ins with an exponential moving average,
+// in date order. The trend starts at the first weigh-in. Across a gap of
+// several days the trend moves as if the new weight had been seen every day
+// of the gap, so a missed week counts for more than a missed day.
+func ComputeWeightTrend(records []WeightRecord, smoothing float64) []TrendPoint {
+	sorted := make([]WeightRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	points := make([]TrendPoint, len(sorted))
+	for i, record := range sorted {
+		trend := record.Weight
+		if i > 0 {
+			previous := points[i-1]
+			days := math.Max(math.Round(record.Date.Sub(previous.Date).Hours()/24), 1)
+			keep := math.Pow(1-smoothing, days)
+			trend = record.Weight + (previous.Trend-record.Weight)*keep
+		}
+		points[i] = TrendPoint{RecordID: record.ID, Date: record.Date, Weight: record.Weight, Trend: trend}
+	}
+	return points
+}
+
+// WeeklyTrendRate returns the trend's change per week between the first and
+// last points, in pounds. It reports false when the points span less than a day.
+func WeeklyTrendRate(points []TrendPoint) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+	first, last := points[0], points[len(points)-1]
+	days := last.Date.Sub(first.Date).Hours() / 24
+	if days < 1 {
+		return 0, false
+	}
+	return (last.Trend - first.Trend) / days * 7, true
+}
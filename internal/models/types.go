@@ -1,7 +1,10 @@
 // internal/models/types.go
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type WeekDay int
 
@@ -23,9 +26,38 @@ const (
 	Regular  MealPattern = "regular"
 )
 
-// Common interfaces that all records will implement
+// ParseMealPattern converts a command-line value to a MealPattern
+func ParseMealPattern(pattern string) (MealPattern, error) {
+	switch mealPattern := MealPattern(pattern); mealPattern {
+	case FullFast, OneMeal, Regular:
+		return mealPattern, nil
+	default:
+		return "", fmt.Errorf("invalid meal pattern: %s (use full-fast, one-meal or regular)", pattern)
+	}
+}
+
+// Common interfaces that all records will implement. Compliance is judged
+// against the user's settings, so each record type takes the rules it needs.
 type Record interface {
 	GetDate() time.Time
-	IsCompliant() bool
 	Validate() error
 }
+
+// CalendarDate returns the local calendar day of t as a UTC midnight date,
+// matching how daily records are stored
+func CalendarDate(t time.Time) time.Time {
+	local := t.In(time.Local)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DateOnly drops the time of day from a stored date, keeping the calendar day
+// in the zone it was recorded in
+func DateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// LocalDayBounds returns the local start and end of the calendar day for date
+func LocalDayBounds(date time.Time) (time.Time, time.Time) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	return start, start.AddDate(0, 0, 1)
+}
@@ -3,21 +3,35 @@ package models
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"time"
 )
 
 // internal/models/weight.go
 type WeightRecord struct {
-	ID     string    `json:"id"`
-	Date   time.Time `json:"date"`
-	Weight float64   `json:"weight"` // in pounds
-	Notes  string    `json:"notes,omitempty"`
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+	// Time is when the weigh-in was taken, for days with several; nil when
+	// only the date was given
+	Time   *time.Time `json:"time,omitempty"`
+	Weight float64    `json:"weight"` // in pounds
+	Notes  string     `json:"notes,omitempty"`
+	// Body composition from a smart scale; zero when not measured
+	BodyFat     float64 `json:"body_fat,omitempty"`     // percent of weight
+	MuscleMass  float64 `json:"muscle_mass,omitempty"`  // in pounds
+	Water       float64 `json:"water,omitempty"`        // percent of weight
+	VisceralFat float64 `json:"visceral_fat,omitempty"` // scale rating, 1-59
 }
 
 func (w WeightRecord) GetDate() time.Time {
 	return w.Date
 }
 
+func (w WeightRecord) GetID() string {
+	return w.ID
+}
+
 func (w WeightRecord) Validate() error {
 	if w.Weight <= 0 {
 		return fmt.Errorf("weight must be greater than 0")
@@ -25,9 +39,96 @@ func (w WeightRecord) Validate() error {
 	if w.Weight > 1000 { // reasonable upper limit
 		return fmt.Errorf("weight seems unreasonably high")
 	}
-	return nil
+	return w.validateComposition()
+}
+
+// IsCompliant reports whether the trend up to this weigh-in is on track for
+// the goal, fitted to the daily weights in history. Without a goal, or without
+// enough weigh-ins to fit a trend, the record is compliant.
+func (w WeightRecord) IsCompliant(goal *WeightGoal, history []WeightRecord) bool {
+	if goal == nil {
+		return true
+	}
+	status, ok := EvaluateWeightGoal(*goal, GoalWindow(history, w.Day()))
+	return !ok || status.OnTrack
+}
+
+// WeightUnit is the unit a weight is entered and shown in. Records store pounds.
+type WeightUnit string
+
+const (
+	Pounds    WeightUnit = "lb"
+	Kilograms WeightUnit = "kg"
+	Stone     WeightUnit = "st"
+
+	kgPerPound     = 0.45359237
+	poundsPerStone = 14.0
+)
+
+// ParseWeightUnit converts a command line value into a WeightUnit
+func ParseWeightUnit(unit string) (WeightUnit, error) {
+	switch strings.ToLower(unit) {
+	case "lb", "lbs", "pound", "pounds":
+		return Pounds, nil
+	case "kg", "kgs", "kilogram", "kilograms", "kilo", "kilos":
+		return Kilograms, nil
+	case "st", "stone", "stones":
+		return Stone, nil
+	}
+	return "", fmt.Errorf("invalid weight unit: %s (use lb, kg or st)", unit)
+}
+
+// ToPounds converts a weight in this unit to pounds
+func (u WeightUnit) ToPounds(weight float64) float64 {
+	switch u {
+	case Kilograms:
+		return weight / kgPerPound
+	case Stone:
+		return weight * poundsPerStone
+	}
+	return weight
+}
+
+// FromPounds converts a weight in pounds to this unit
+func (u WeightUnit) FromPounds(pounds float64) float64 {
+	switch u {
+	case Kilograms:
+		return pounds * kgPerPound
+	case Stone:
+		return pounds / poundsPerStone
+	}
+	return pounds
+}
+
+// Format shows a weight in pounds in this unit, with stone split into
+// stone and pounds as it is usually written
+func (u WeightUnit) Format(pounds float64) string {
+	switch u {
+	case Kilograms:
+		return fmt.Sprintf("%.1f kg", u.FromPounds(pounds))
+	case Stone:
+		stone := math.Floor(pounds / poundsPerStone)
+		remainder := pounds - stone*poundsPerStone
+		// Avoid showing 13 st 14.0 lb when the remainder rounds up
+		if math.Round(remainder*10)/10 >= poundsPerStone {
+			stone, remainder = stone+1, 0
+		}
+		return fmt.Sprintf("%.0f st %.1f lb", stone, remainder)
+	}
+	return fmt.Sprintf("%.1f lbs", pounds)
+}
+
+// FormatChange shows a difference in pounds in this unit. Changes in stone
+// are given in pounds, which is how they are usually counted.
+func (u WeightUnit) FormatChange(pounds float64) string {
+	if u == Kilograms {
+		return fmt.Sprintf("%.1f kg", u.FromPounds(pounds))
+	}
+	return fmt.Sprintf("%.1f lbs", pounds)
 }
 
-func (w WeightRecord) IsCompliant() bool {
-	return true // Weight records are always compliant - they're measurements
+// WeightInUnit pairs a record with the unit it should be shown in
+type WeightInUnit struct {
+	Record WeightRecord
+	Unit   WeightUnit
 }
@@ -0,0 +1,148 @@
+// internal/models/weight_daily.go
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DailyWeightPolicy picks a day's weight when it has several weigh-ins
+type DailyWeightPolicy string
+
+const (
+	DailyFirst   DailyWeightPolicy = "first"   // the earliest weigh-in, usually on waking
+	DailyMin     DailyWeightPolicy = "min"     // the lightest weigh-in
+	DailyAverage DailyWeightPolicy = "average" // the mean of the day's weigh-ins
+)
+
+// ParseDailyWeightPolicy converts a command line value into a DailyWeightPolicy
+func ParseDailyWeightPolicy(policy string) (DailyWeightPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "first":
+		return DailyFirst, nil
+	case "min", "minimum", "lowest":
+		return DailyMin, nil
+	case "average", "avg", "mean":
+		return DailyAverage, nil
+	}
+	return "", fmt.Errorf("invalid daily weight policy: %s (use first, min or average)", policy)
+}
+
+// Day returns the calendar date of the weigh-in
+func (w WeightRecord) Day() time.Time {
+	return DateOnly(w.Date)
+}
+
+// FormatWhen shows the weigh-in's date, with its time of day when known
+func (w WeightRecord) FormatWhen() string {
+	if w.Time == nil {
+		return w.Date.Format("2006-01-02")
+	}
+	return w.Time.Format("2006-01-02 15:04")
+}
+
+// SameWeighIn reports whether two records are for the same day and time of
+// day, or are both for the same day without a time
+func (w WeightRecord) SameWeighIn(other WeightRecord) bool {
+	if !w.Day().Equal(other.Day()) {
+		return false
+	}
+	if w.Time == nil || other.Time == nil {
+		return w.Time == nil && other.Time == nil
+	}
+	return w.Time.Equal(*other.Time)
+}
+
+// SortWeighIns orders weigh-ins by day and then time of day. Weigh-ins
+// without a time come first on their day, in the order they were added.
+func SortWeighIns(records []WeightRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if !a.Day().Equal(b.Day()) {
+			return a.Day().Before(b.Day())
+		}
+		if a.Time == nil || b.Time == nil {
+			return a.Time == nil && b.Time != nil
+		}
+		return a.Time.Before(*b.Time)
+	})
+}
+
+// DailyWeight is the canonical weight for a day and the weigh-ins it came from
+type DailyWeight struct {
+	Record  WeightRecord // the chosen weigh-in, or the first with averaged readings
+	Entries int
+}
+
+// DailyWeights reduces weigh-ins to one per day by the policy, in date order
+func DailyWeights(records []WeightRecord, policy DailyWeightPolicy) []DailyWeight {
+	sorted := make([]WeightRecord, len(records))
+	copy(sorted, records)
+	SortWeighIns(sorted)
+
+	var days []DailyWeight
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Day().Equal(sorted[start].Day()) {
+			end++
+		}
+		days = append(days, DailyWeight{Record: dailyRecord(sorted[start:end], policy), Entries: end - start})
+		start = end
+	}
+	return days
+}
+
+// DailyRecords returns just the canonical records of DailyWeights
+func DailyRecords(records []WeightRecord, policy DailyWeightPolicy) []WeightRecord {
+	days := DailyWeights(records, policy)
+	daily := make([]WeightRecord, len(days))
+	for i, day := range days {
+		daily[i] = day.Record
+	}
+	return daily
+}
+
+// dailyRecord applies the policy to one day's weigh-ins, in time order
+func dailyRecord(entries []WeightRecord, policy DailyWeightPolicy) WeightRecord {
+	switch policy {
+	case DailyMin:
+		lightest := entries[0]
+		for _, entry := range entries[1:] {
+			if entry.Weight < lightest.Weight {
+				lightest = entry
+			}
+		}
+		return lightest
+	case DailyAverage:
+		if len(entries) == 1 {
+			return entries[0]
+		}
+		average := entries[0]
+		average.Time = nil
+		average.Weight = meanReading(entries, func(w WeightRecord) float64 { return w.Weight })
+		average.BodyFat = meanReading(entries, func(w WeightRecord) float64 { return w.BodyFat })
+		average.MuscleMass = meanReading(entries, func(w WeightRecord) float64 { return w.MuscleMass })
+		average.Water = meanReading(entries, func(w WeightRecord) float64 { return w.Water })
+		average.VisceralFat = meanReading(entries, func(w WeightRecord) float64 { return w.VisceralFat })
+		return average
+	}
+	return entries[0]
+}
+
+// meanReading averages a reading over the weigh-ins that measured it
+func meanReading(entries []WeightRecord, reading func(WeightRecord) float64) float64 {
+	var total float64
+	var count int
+	for _, entry := range entries {
+		if value := reading(entry); value != 0 {
+			total += value
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
@@ -0,0 +1,189 @@
+// internal/models/weight_goal.go
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// DefaultGoalWindow is how many days of recent weigh-ins the goal projection
+// is fitted to
+const DefaultGoalWindow = 28
+
+// confidenceZ widens the fitted rate to an approximate 95% band
+const confidenceZ = 2.0
+
+// WeightGoal is a target weight to reach by a date
+type WeightGoal struct {
+	Target float64   `json:"target"` // in pounds
+	By     time.Time `json:"by"`
+	SetOn  time.Time `json:"set_on"`
+	Start  float64   `json:"start"` // latest weight when the goal was set, in pounds
+}
+
+// IsLoss reports whether the goal is to lose weight rather than gain it
+func (g WeightGoal) IsLoss() bool {
+	return g.Target < g.Start
+}
+
+// Validate checks the goal's own fields
+func (g WeightGoal) Validate() error {
+	if g.Target <= 0 {
+		return fmt.Errorf("goal weight must be greater than 0")
+	}
+	if g.Target == g.Start {
+		return fmt.Errorf("goal weight is the same as the current weight")
+	}
+	if !g.By.After(g.SetOn) {
+		return fmt.Errorf("goal date must be after %s", g.SetOn.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// WeightRegression is a least-squares line through weigh-ins
+type WeightRegression struct {
+	Origin     time.Time // date of the first weigh-in, day 0 of the line
+	Intercept  float64   // pounds on the origin date
+	Slope      float64   // pounds per day
+	SlopeError float64   // standard error of the slope, 0 with fewer than 3 weigh-ins
+	Points     int
+}
+
+// FitWeightRegression fits a line to weigh-ins. It reports false when they
+// don't span at least two different days.
+func FitWeightRegression(records []WeightRecord) (WeightRegression, bool) {
+	if len(records) < 2 {
+		return WeightRegression{}, false
+	}
+	sorted := make([]WeightRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	fit := WeightRegression{Origin: sorted[0].Date, Points: len(sorted)}
+	xs := make([]float64, len(sorted))
+	var meanX, meanY float64
+	for i, record := range sorted {
+		xs[i] = record.Date.Sub(fit.Origin).Hours() / 24
+		meanX += xs[i]
+		meanY += record.Weight
+	}
+	n := float64(len(sorted))
+	meanX /= n
+	meanY /= n
+
+	var sxx, sxy float64
+	for i, record := range sorted {
+		sxx += (xs[i] - meanX) * (xs[i] - meanX)
+		sxy += (xs[i] - meanX) * (record.Weight - meanY)
+	}
+	if sxx == 0 {
+		return WeightRegression{}, false
+	}
+	fit.Slope = sxy / sxx
+	fit.Intercept = meanY - fit.Slope*meanX
+
+	if len(sorted) > 2 {
+		var sse float64
+		for i, record := range sorted {
+			residual := record.Weight - (fit.Intercept + fit.Slope*xs[i])
+			sse += residual * residual
+		}
+		fit.SlopeError = math.Sqrt(sse / (n - 2) / sxx)
+	}
+	return fit, true
+}
+
+// WeightOn returns the fitted weight on a date
+func (r WeightRegression) WeightOn(date time.Time) float64 {
+	return r.Intercept + r.Slope*date.Sub(r.Origin).Hours()/24
+}
+
+// WeightGoalStatus compares progress toward a goal with what is needed
+type WeightGoalStatus struct {
+	Goal         WeightGoal
+	AsOf         time.Time  // the latest weigh-in used
+	Current      float64    // fitted weight as of AsOf, in pounds
+	Remaining    float64    // pounds still to lose, negative to gain
+	Rate         float64    // fitted change in pounds per week
+	RequiredRate float64    // change per week needed to arrive on time
+	Projected    *time.Time // arrival at the current rate, nil if not heading there
+	Earliest     *time.Time // arrival at the fast end of the confidence band
+	Latest       *time.Time // arrival at the slow end, nil if it may never arrive
+	Reached      bool
+	OnTrack      bool
+}
+
+// GoalWindow returns the weigh-ins in the DefaultGoalWindow days up to and
+// including a date
+func GoalWindow(records []WeightRecord, through time.Time) []WeightRecord {
+	from := through.AddDate(0, 0, -(DefaultGoalWindow - 1))
+	var window []WeightRecord
+	for _, record := range records {
+		if !record.Day().Before(from) && !record.Day().After(through) {
+			window = append(window, record)
+		}
+	}
+	return window
+}
+
+// EvaluateWeightGoal projects when the goal will be reached from a line fitted
+// to recent weigh-ins. It reports false without enough weigh-ins to fit a line.
+func EvaluateWeightGoal(goal WeightGoal, records []WeightRecord) (WeightGoalStatus, bool) {
+	fit, ok := FitWeightRegression(records)
+	if !ok {
+		return WeightGoalStatus{}, false
+	}
+
+	status := WeightGoalStatus{Goal: goal}
+	for _, record := range records {
+		if record.Date.After(status.AsOf) {
+			status.AsOf = record.Date
+		}
+	}
+	status.Current = fit.WeightOn(status.AsOf)
+	status.Remaining = status.Current - goal.Target
+	status.Rate = fit.Slope * 7
+
+	daysLeft := goal.By.Sub(status.AsOf).Hours() / 24
+	if daysLeft > 0 {
+		status.RequiredRate = -status.Remaining / daysLeft * 7
+	}
+
+	// A goal to lose weight is reached at or below the target, and one to gain at or above
+	losing := goal.IsLoss()
+	if (losing && status.Remaining <= 0) || (!losing && status.Remaining >= 0) {
+		status.Reached = true
+		status.OnTrack = true
+		return status, true
+	}
+
+	status.Projected = arrival(status.AsOf, status.Remaining, fit.Slope)
+	fast, slow := fit.Slope-confidenceZ*fit.SlopeError, fit.Slope+confidenceZ*fit.SlopeError
+	if !losing {
+		fast, slow = slow, fast
+	}
+	status.Earliest = arrival(status.AsOf, status.Remaining, fast)
+	status.Latest = arrival(status.AsOf, status.Remaining, slow)
+
+	status.OnTrack = status.Projected != nil && !status.Projected.After(goal.By)
+	return status, true
+}
+
+// maxProjectionDays keeps a nearly flat rate from projecting centuries ahead
+const maxProjectionDays = 10 * 365
+
+// arrival returns when remaining pounds are covered at a daily rate, or nil
+// when the rate isn't heading toward the goal
+func arrival(from time.Time, remaining, slope float64) *time.Time {
+	if slope == 0 {
+		return nil
+	}
+	days := math.Ceil(-remaining / slope)
+	if days <= 0 || days > maxProjectionDays {
+		return nil
+	}
+	date := from.AddDate(0, 0, int(days))
+	return &date
+}
@@ -0,0 +1,113 @@
+// internal/models/window.go
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Hours of fasting within a calendar day needed for each derived pattern
+const (
+	FullFastHours = 23.0 // essentially the whole day
+	OneMealHours  = 20.0 // an eating window of 4 hours or less
+)
+
+// FastingWindow is a timed fast, which may span several calendar days
+type FastingWindow struct {
+	Start    time.Time  `json:"start"`
+	End      *time.Time `json:"end,omitempty"`      // nil while the fast is in progress
+	Duration float64    `json:"duration,omitempty"` // in hours, set when the fast ends
+	Notes    string     `json:"notes,omitempty"`
+}
+
+func (w FastingWindow) GetDate() time.Time {
+	return w.Start
+}
+
+func (w FastingWindow) Validate() error {
+	if w.Start.IsZero() {
+		return fmt.Errorf("fast start time is required")
+	}
+	if w.End != nil && !w.End.After(w.Start) {
+		return fmt.Errorf("fast must end after it starts")
+	}
+	return nil
+}
+
+// InProgress reports whether the fast has not been stopped yet
+func (w FastingWindow) InProgress() bool {
+	return w.End == nil
+}
+
+// Elapsed returns the length of the fast, measured up to now if it is still running
+func (w FastingWindow) Elapsed(now time.Time) time.Duration {
+	if w.End != nil {
+		return w.End.Sub(w.Start)
+	}
+	return now.Sub(w.Start)
+}
+
+// Stop ends the fast and records its duration
+func (w *FastingWindow) Stop(end time.Time) error {
+	w.End = &end
+	if err := w.Validate(); err != nil {
+		w.End = nil
+		return err
+	}
+	w.Duration = end.Sub(w.Start).Hours()
+	return nil
+}
+
+// Days returns the calendar days touched by the fast, as UTC midnight dates
+func (w FastingWindow) Days(now time.Time) []time.Time {
+	end := now
+	if w.End != nil {
+		end = *w.End
+	}
+
+	var days []time.Time
+	for day, _ := LocalDayBounds(w.Start.In(time.Local)); day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, CalendarDate(day))
+	}
+	return days
+}
+
+// fastedDuring returns how much of the fast falls within [from, to)
+func (w FastingWindow) fastedDuring(from, to, now time.Time) time.Duration {
+	start := w.Start
+	end := now
+	if w.End != nil {
+		end = *w.End
+	}
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// DeriveMealPattern works out the meal pattern for a calendar day from the
+// fasting windows that overlap it. Time outside a window counts as eating time.
+func DeriveMealPattern(windows []FastingWindow, date time.Time) MealPattern {
+	from, to := LocalDayBounds(date)
+	now := time.Now()
+
+	var fasted time.Duration
+	for _, window := range windows {
+		fasted += window.fastedDuring(from, to, now)
+	}
+
+	switch hours := fasted.Hours(); {
+	case hours >= FullFastHours:
+		return FullFast
+	case hours >= OneMealHours:
+		return OneMeal
+	default:
+		return Regular
+	}
+}
@@ -0,0 +1,156 @@
+// internal/storage/ids.go
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+)
+
+// idPrefixes maps each record type with IDs to the letter its IDs start with.
+// Record types given IDs later only need an entry here.
+var idPrefixes = map[string]string{
+	"weight":   WeightIDPrefix,
+	"exercise": ExerciseIDPrefix,
+	"gear":     GearIDPrefix,
+}
+
+// formatID builds an ID from a record type's prefix and a number
+func formatID(prefix string, number int) string {
+	return fmt.Sprintf("%s%0*d", prefix, IDLength, number)
+}
+
+// idNumber returns the number in an ID with the given prefix, or false for
+// IDs that don't have the prefix and digits
+func idNumber(prefix, id string) (int, bool) {
+	digits, found := strings.CutPrefix(id, prefix)
+	if !found || digits == "" {
+		return 0, false
+	}
+	number, err := strconv.Atoi(digits)
+	if err != nil || number < 0 {
+		return 0, false
+	}
+	return number, true
+}
+
+// allocateIDs hands out count new IDs for a record type. Numbers only ever
+// increase, so an ID freed by a deletion is never reused. The counter never
+// falls below the highest ID already stored, which covers data written before
+// the counter existed. The caller must hold the lock on the record type's file.
+func allocateIDs[T identifiedRecord](s *JSONStorage, recordType string, records []T, count int) ([]string, error) {
+	prefix, ok := idPrefixes[recordType]
+	if !ok {
+		return nil, fmt.Errorf("%s records don't have IDs", recordType)
+	}
+
+	filepath := s.getFilePath("ids")
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	counters := map[string]int{}
+	data, err := os.ReadFile(filepath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read ID counter file: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &counters); err != nil {
+			return nil, fmt.Errorf("failed to parse ID counters: %w", err)
+		}
+	}
+
+	last := counters[recordType]
+	for _, record := range records {
+		if number, ok := idNumber(prefix, record.GetID()); ok && number > last {
+			last = number
+		}
+	}
+
+	ids := make([]string, count)
+	for i := range ids {
+		last++
+		ids[i] = formatID(prefix, last)
+	}
+	counters[recordType] = last
+
+	updatedData, err := json.MarshalIndent(counters, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ID counters: %w", err)
+	}
+	if err := os.WriteFile(filepath, updatedData, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write ID counter file: %w", err)
+	}
+
+	return ids, nil
+}
+
+// allocateID hands out one new ID for a record type, as allocateIDs
+func allocateID[T identifiedRecord](s *JSONStorage, recordType string, records []T) (string, error) {
+	ids, err := allocateIDs(s, recordType, records, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// repairRecordIDs gives new IDs to records that have none or share an ID with an
+// earlier record. The first record with an ID keeps it, so references made
+// before the duplicate was written still find the original.
+func repairRecordIDs[T identifiedRecord](s *JSONStorage, recordType string, setID func(*T, string)) error {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	seen := make(map[string]bool, len(records))
+	var repair []int
+	for i, record := range records {
+		id := record.GetID()
+		if id == "" || seen[id] {
+			repair = append(repair, i)
+			continue
+		}
+		seen[id] = true
+	}
+	if len(repair) == 0 {
+		return nil
+	}
+
+	ids, err := allocateIDs(s, recordType, records, len(repair))
+	if err != nil {
+		return err
+	}
+	for i, index := range repair {
+		setID(&records[index], ids[i])
+	}
+
+	return writeRecords(filepath, recordType, records)
+}
+
+// repairIDs fixes missing and duplicate IDs in every record type with IDs
+func (s *JSONStorage) repairIDs() error {
+	if err := repairRecordIDs(s, "weight", func(r *models.WeightRecord, id string) { r.ID = id }); err != nil {
+		return err
+	}
+	if err := repairRecordIDs(s, "exercise", func(r *models.ExerciseRecord, id string) { r.ID = id }); err != nil {
+		return err
+	}
+	return repairRecordIDs(s, "gear", func(g *models.Gear, id string) { g.ID = id })
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath" // Add this
+	"sort"
 	"sync"
 	"time"
 
@@ -14,8 +15,10 @@ import (
 )
 
 const (
-	WeightIDPrefix = "w"
-	IDLength       = 5 // number of digits after the prefix
+	WeightIDPrefix   = "w"
+	ExerciseIDPrefix = "e"
+	GearIDPrefix     = "g"
+	IDLength         = 5 // number of digits after the prefix
 )
 
 const (
@@ -31,6 +34,13 @@ const (
 	ExerciseFileName = "exercise.json"
 	FastingFileName  = "fasting.json"
 	SodaFileName     = "soda.json"
+	SettingsFileName = "settings.json"
+	GearFileName     = "gear.json"
+
+	FastingWindowsFileName = "fasting_windows.json"
+	MealsFileName          = "meals.json"
+	SodaEntriesFileName    = "soda_entries.json"
+	IDCounterFileName      = "ids.json" // the last ID number handed out for each record type
 )
 
 // JSONStorage handles persistence of records to JSON files
@@ -41,51 +51,32 @@ type JSONStorage struct {
 	mu        sync.RWMutex
 }
 
-// Add this helper function to generate IDs
-func generateID(prefix string, currentRecords int) string {
-	return fmt.Sprintf("%s%05d", prefix, currentRecords+1)
-}
-
 // Exercise record implementations
-func (s *JSONStorage) AddExercise(record models.ExerciseRecord) error {
+// AddExercise stores a session under a new ID. Several sessions may share a date.
+func (s *JSONStorage) AddExercise(record models.ExerciseRecord) (models.ExerciseRecord, error) {
 	filepath := s.getFilePath("exercise")
 	lock := s.getLock(filepath)
 
 	lock.Lock()
 	defer lock.Unlock()
 
-	// Read existing records
 	data, err := os.ReadFile(filepath)
 	if err != nil {
-		return fmt.Errorf("failed to read exercise file: %w", err)
+		return record, fmt.Errorf("failed to read exercise file: %w", err)
 	}
 
 	var records []models.ExerciseRecord
 	if err := json.Unmarshal(data, &records); err != nil {
-		return fmt.Errorf("failed to parse exercise data: %w", err)
-	}
-
-	// Check for duplicate date
-	for _, r := range records {
-		if r.Date.Equal(record.Date) {
-			return fmt.Errorf("duplicate_date")
-		}
+		return record, fmt.Errorf("failed to parse exercise data: %w", err)
 	}
 
-	// Add new record
-	records = append(records, record)
-
-	// Write back to file
-	updatedData, err := json.MarshalIndent(records, "", "    ")
+	record.ID, err = allocateID(s, "exercise", records)
 	if err != nil {
-		return fmt.Errorf("failed to marshal exercise data: %w", err)
-	}
-
-	if err := os.WriteFile(filepath, updatedData, 0644); err != nil {
-		return fmt.Errorf("failed to write exercise file: %w", err)
+		return record, err
 	}
+	records = append(records, record)
 
-	return nil
+	return record, writeRecords(filepath, "exercise", records)
 }
 
 func (s *JSONStorage) GetExerciseRange(start, end time.Time, isDefaultRange bool) ([]models.ExerciseRecord, error) {
@@ -120,8 +111,9 @@ func (s *JSONStorage) GetExerciseRange(start, end time.Time, isDefaultRange bool
 				filtered = append(filtered, record)
 			}
 		} else {
-			if (record.Date.Equal(start) || record.Date.After(start)) &&
-				(record.Date.Equal(end) || record.Date.Before(end)) {
+			// Compare calendar days so sessions stored with a time of day still match
+			day := models.DateOnly(record.Date)
+			if !day.Before(models.DateOnly(start)) && !day.After(models.DateOnly(end)) {
 				filtered = append(filtered, record)
 			}
 		}
@@ -130,20 +122,13 @@ func (s *JSONStorage) GetExerciseRange(start, end time.Time, isDefaultRange bool
 	return filtered, nil
 }
 
-func (s *JSONStorage) GetExercise(date time.Time) (*models.ExerciseRecord, error) {
-	records, err := s.GetExerciseRange(date, date, false)
-	if err != nil {
-		return nil, err
-	}
-	if len(records) == 0 {
-		return nil, nil
-	}
-	return &records[0], nil
+func (s *JSONStorage) GetExerciseByID(id string) (*models.ExerciseRecord, error) {
+	return getRecordByID[models.ExerciseRecord](s, "exercise", id)
 }
 
 // Fasting record implementations
 func (s *JSONStorage) AddFasting(record models.FastingRecord) error {
-	return s.addRecord("fasting", record)
+	return addRecord(s, "fasting", sameDay, record)
 }
 
 func (s *JSONStorage) GetFastingRange(start, end time.Time, isDefaultRange bool) ([]models.FastingRecord, error) {
@@ -178,8 +163,9 @@ func (s *JSONStorage) GetFastingRange(start, end time.Time, isDefaultRange bool)
 				filtered = append(filtered, record)
 			}
 		} else {
-			if (record.Date.Equal(start) || record.Date.After(start)) &&
-				(record.Date.Equal(end) || record.Date.Before(end)) {
+			// Compare calendar days so sessions stored with a time of day still match
+			day := models.DateOnly(record.Date)
+			if !day.Before(models.DateOnly(start)) && !day.After(models.DateOnly(end)) {
 				filtered = append(filtered, record)
 			}
 		}
@@ -199,9 +185,168 @@ func (s *JSONStorage) GetFasting(date time.Time) (*models.FastingRecord, error)
 	return &records[0], nil
 }
 
+func (s *JSONStorage) UpdateFasting(date time.Time, record models.FastingRecord) error {
+	return updateRecord(s, "fasting", sameDay, date, record)
+}
+
+func (s *JSONStorage) DeleteFasting(date time.Time) error {
+	return deleteRecord[models.FastingRecord](s, "fasting", sameDay, date)
+}
+
+// Fasting window implementations
+func (s *JSONStorage) AddFastingWindow(window models.FastingWindow) error {
+	return addRecord(s, "fasting_windows", sameMoment, window)
+}
+
+func (s *JSONStorage) GetActiveFastingWindow() (*models.FastingWindow, error) {
+	windows, err := s.getFastingWindows()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, window := range windows {
+		if window.InProgress() {
+			return &window, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetFastingWindowRange returns the windows that overlap the given period
+func (s *JSONStorage) GetFastingWindowRange(start, end time.Time) ([]models.FastingWindow, error) {
+	windows, err := s.getFastingWindows()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []models.FastingWindow
+	for _, window := range windows {
+		if window.Start.After(end) {
+			continue
+		}
+		if window.End != nil && window.End.Before(start) {
+			continue
+		}
+		filtered = append(filtered, window)
+	}
+
+	return filtered, nil
+}
+
+func (s *JSONStorage) UpdateFastingWindow(start time.Time, window models.FastingWindow) error {
+	return updateRecord(s, "fasting_windows", sameMoment, start, window)
+}
+
+func (s *JSONStorage) DeleteFastingWindow(start time.Time) error {
+	return deleteRecord[models.FastingWindow](s, "fasting_windows", sameMoment, start)
+}
+
+func (s *JSONStorage) getFastingWindows() ([]models.FastingWindow, error) {
+	filepath := s.getFilePath("fasting_windows")
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fasting_windows file: %w", err)
+	}
+
+	var windows []models.FastingWindow
+	if err := json.Unmarshal(data, &windows); err != nil {
+		return nil, fmt.Errorf("failed to parse fasting_windows data: %w", err)
+	}
+
+	return windows, nil
+}
+
+// Meal entry implementations
+func (s *JSONStorage) AddMeal(meal models.MealEntry) error {
+	return addRecord(s, "meals", sameMoment, meal)
+}
+
+// GetMealRange returns the meals eaten in [start, end)
+func (s *JSONStorage) GetMealRange(start, end time.Time) ([]models.MealEntry, error) {
+	filepath := s.getFilePath("meals")
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read meals file: %w", err)
+	}
+
+	var meals []models.MealEntry
+	if err := json.Unmarshal(data, &meals); err != nil {
+		return nil, fmt.Errorf("failed to parse meals data: %w", err)
+	}
+
+	var filtered []models.MealEntry
+	for _, meal := range meals {
+		if !meal.Time.Before(start) && meal.Time.Before(end) {
+			filtered = append(filtered, meal)
+		}
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Time.Before(filtered[j].Time)
+	})
+
+	return filtered, nil
+}
+
+func (s *JSONStorage) DeleteMeal(at time.Time) error {
+	return deleteRecord[models.MealEntry](s, "meals", sameMoment, at)
+}
+
+// Soda entry implementations
+func (s *JSONStorage) AddSodaEntry(entry models.SodaEntry) error {
+	return addRecord(s, "soda_entries", sameMoment, entry)
+}
+
+// GetSodaEntryRange returns the drinks logged in [start, end)
+func (s *JSONStorage) GetSodaEntryRange(start, end time.Time) ([]models.SodaEntry, error) {
+	filepath := s.getFilePath("soda_entries")
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read soda entries file: %w", err)
+	}
+
+	var entries []models.SodaEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse soda entries data: %w", err)
+	}
+
+	var filtered []models.SodaEntry
+	for _, entry := range entries {
+		if !entry.Time.Before(start) && entry.Time.Before(end) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Time.Before(filtered[j].Time)
+	})
+
+	return filtered, nil
+}
+
+func (s *JSONStorage) DeleteSodaEntry(at time.Time) error {
+	return deleteRecord[models.SodaEntry](s, "soda_entries", sameMoment, at)
+}
+
 // Soda record implementations
 func (s *JSONStorage) AddSoda(record models.SodaRecord) error {
-	return s.addRecord("soda", record)
+	return addRecord(s, "soda", sameDay, record)
 }
 
 func (s *JSONStorage) GetSodaRange(start, end time.Time, isDefaultRange bool) ([]models.SodaRecord, error) {
@@ -236,8 +381,9 @@ func (s *JSONStorage) GetSodaRange(start, end time.Time, isDefaultRange bool) ([
 				filtered = append(filtered, record)
 			}
 		} else {
-			if (record.Date.Equal(start) || record.Date.After(start)) &&
-				(record.Date.Equal(end) || record.Date.Before(end)) {
+			// Compare calendar days so sessions stored with a time of day still match
+			day := models.DateOnly(record.Date)
+			if !day.Before(models.DateOnly(start)) && !day.After(models.DateOnly(end)) {
 				filtered = append(filtered, record)
 			}
 		}
@@ -257,6 +403,14 @@ func (s *JSONStorage) GetSoda(date time.Time) (*models.SodaRecord, error) {
 	return &records[0], nil
 }
 
+func (s *JSONStorage) UpdateSoda(date time.Time, record models.SodaRecord) error {
+	return updateRecord(s, "soda", sameDay, date, record)
+}
+
+func (s *JSONStorage) DeleteSoda(date time.Time) error {
+	return deleteRecord[models.SodaRecord](s, "soda", sameDay, date)
+}
+
 func (s *JSONStorage) getFilePath(recordType string) string {
 	filename := fmt.Sprintf("%s.json", recordType)
 	return filepath.Join(s.rootDir, s.dataDir, filename)
@@ -275,8 +429,24 @@ func (s *JSONStorage) getLock(filepath string) *sync.RWMutex {
 	return lock
 }
 
-// Generic function to add a record to a JSON file
-func (s *JSONStorage) addRecord(recordType string, record interface{}) error {
+// datedRecord is implemented by record types that are looked up by their date
+type datedRecord interface {
+	GetDate() time.Time
+}
+
+// sameDay matches records stored once per calendar day, whatever their time of day
+func sameDay(a, b time.Time) bool {
+	return models.DateOnly(a).Equal(models.DateOnly(b))
+}
+
+// sameMoment matches records keyed by their exact timestamp
+func sameMoment(a, b time.Time) bool {
+	return a.Equal(b)
+}
+
+// addRecord appends a record to a JSON file, rejecting a second record that
+// matches the same date
+func addRecord[T datedRecord](s *JSONStorage, recordType string, match func(a, b time.Time) bool, record T) error {
 	filepath := s.getFilePath(recordType)
 	lock := s.getLock(filepath)
 
@@ -289,15 +459,191 @@ func (s *JSONStorage) addRecord(recordType string, record interface{}) error {
 		return fmt.Errorf("failed to read %s file: %w", recordType, err)
 	}
 
-	var records []interface{}
+	var records []T
 	if err := json.Unmarshal(data, &records); err != nil {
 		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
 	}
 
+	// Check for duplicate date
+	for _, r := range records {
+		if match(r.GetDate(), record.GetDate()) {
+			return fmt.Errorf("duplicate_date")
+		}
+	}
+
 	// Add new record
 	records = append(records, record)
 
-	// Write back to file
+	return writeRecords(filepath, recordType, records)
+}
+
+// updateRecord replaces the record stored for date
+func updateRecord[T datedRecord](s *JSONStorage, recordType string, match func(a, b time.Time) bool, date time.Time, record T) error {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	// Find the record, making sure a changed date doesn't collide with another day
+	index := -1
+	for i, r := range records {
+		if match(r.GetDate(), date) {
+			index = i
+		} else if match(r.GetDate(), record.GetDate()) {
+			return fmt.Errorf("duplicate_date")
+		}
+	}
+
+	if index == -1 {
+		return fmt.Errorf("record not found for date: %s", date.Format(validator.DateFormat))
+	}
+	records[index] = record
+
+	return writeRecords(filepath, recordType, records)
+}
+
+// deleteRecord removes the record stored for date
+func deleteRecord[T datedRecord](s *JSONStorage, recordType string, match func(a, b time.Time) bool, date time.Time) error {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	// Find and remove the record
+	newRecords := make([]T, 0, len(records))
+	found := false
+	for _, record := range records {
+		if match(record.GetDate(), date) {
+			found = true
+			continue
+		}
+		newRecords = append(newRecords, record)
+	}
+
+	if !found {
+		return fmt.Errorf("record not found for date: %s", date.Format(validator.DateFormat))
+	}
+
+	return writeRecords(filepath, recordType, newRecords)
+}
+
+// identifiedRecord is implemented by record types keyed by ID, so several
+// records can share a date
+type identifiedRecord interface {
+	GetID() string
+}
+
+// getRecordByID returns the record with id, or nil if there is none
+func getRecordByID[T identifiedRecord](s *JSONStorage, recordType string, id string) (*T, error) {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	for _, record := range records {
+		if record.GetID() == id {
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateRecordByID replaces the record with id
+func updateRecordByID[T identifiedRecord](s *JSONStorage, recordType string, id string, record T) error {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	for i := range records {
+		if records[i].GetID() == id {
+			records[i] = record
+			return writeRecords(filepath, recordType, records)
+		}
+	}
+
+	return fmt.Errorf("record not found: %s", id)
+}
+
+// deleteRecordByID removes the record with id
+func deleteRecordByID[T identifiedRecord](s *JSONStorage, recordType string, id string) error {
+	filepath := s.getFilePath(recordType)
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s file: %w", recordType, err)
+	}
+
+	var records []T
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("failed to parse %s data: %w", recordType, err)
+	}
+
+	newRecords := make([]T, 0, len(records))
+	for _, record := range records {
+		if record.GetID() != id {
+			newRecords = append(newRecords, record)
+		}
+	}
+
+	if len(newRecords) == len(records) {
+		return fmt.Errorf("record not found: %s", id)
+	}
+
+	return writeRecords(filepath, recordType, newRecords)
+}
+
+// writeRecords marshals records and writes them back to filepath
+func writeRecords[T any](filepath, recordType string, records []T) error {
 	updatedData, err := json.MarshalIndent(records, "", "    ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal %s data: %w", recordType, err)
@@ -323,6 +669,11 @@ func (s *JSONStorage) Init() error {
 		"exercise": ExerciseFileName,
 		"fasting":  FastingFileName,
 		"soda":     SodaFileName,
+		"gear":     GearFileName,
+
+		"fasting_windows": FastingWindowsFileName,
+		"meals":           MealsFileName,
+		"soda_entries":    SodaEntriesFileName,
 	}
 
 	for _, filename := range files {
@@ -334,6 +685,27 @@ func (s *JSONStorage) Init() error {
 		}
 	}
 
+	// Settings and ID counters are single objects rather than lists of records
+	for _, filename := range []string{SettingsFileName, IDCounterFileName} {
+		filepath := filepath.Join(fullPath, filename)
+		if _, err := os.Stat(filepath); os.IsNotExist(err) {
+			if err := os.WriteFile(filepath, []byte("{}"), 0644); err != nil {
+				return fmt.Errorf("failed to create file %s: %w", filepath, err)
+			}
+		}
+	}
+
+	// Exercise records logged before IDs existed need one to be updated or
+	// deleted, and IDs once derived from the record count may be duplicated
+	if err := s.repairIDs(); err != nil {
+		return err
+	}
+
+	// Free-text "other" activities become entries in the activity catalog
+	if err := s.migrateOtherActivities(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -388,22 +760,18 @@ func (s *JSONStorage) AddWeight(record models.WeightRecord) (models.WeightRecord
 		return record, fmt.Errorf("failed to parse weight data: %w", err)
 	}
 
-	// Check for existing record on same date
-	recordIndex := -1
-	for i, r := range records {
-		if r.Date.Equal(record.Date) {
-			recordIndex = i
-			break
+	// A day can have several weigh-ins, but not two at the same time
+	for _, r := range records {
+		if r.SameWeighIn(record) {
+			return models.WeightRecord{}, fmt.Errorf("duplicate_weigh_in")
 		}
 	}
 
-	// If duplicate found, return error
-	if recordIndex != -1 {
-		return models.WeightRecord{}, fmt.Errorf("duplicate_date")
-	}
-
 	// Generate new ID and add record
-	record.ID = generateID(WeightIDPrefix, len(records))
+	record.ID, err = allocateID(s, "weight", records)
+	if err != nil {
+		return record, err
+	}
 	records = append(records, record)
 
 	updatedData, err := json.MarshalIndent(records, "", "    ")
@@ -418,6 +786,7 @@ func (s *JSONStorage) AddWeight(record models.WeightRecord) (models.WeightRecord
 	return record, nil
 }
 
+// GetWeight returns the first weigh-in of a date
 func (s *JSONStorage) GetWeight(date time.Time) (*models.WeightRecord, error) {
 	records, err := s.GetWeightRange(date, date, false) // false since this is a specific date query
 	if err != nil {
@@ -426,6 +795,7 @@ func (s *JSONStorage) GetWeight(date time.Time) (*models.WeightRecord, error) {
 	if len(records) == 0 {
 		return nil, nil
 	}
+	models.SortWeighIns(records)
 	return &records[0], nil
 }
 
@@ -500,8 +870,13 @@ func (s *JSONStorage) GetLastWeightRecord() (*models.WeightRecord, error) {
 		return nil, nil
 	}
 
-	// Return the most recent record
-	lastRecord := &records[len(records)-1]
+	// Return the most recent record by date; records are kept in the order added
+	lastRecord := &records[0]
+	for i := range records {
+		if !records[i].Date.Before(lastRecord.Date) {
+			lastRecord = &records[i]
+		}
+	}
 	return lastRecord, nil
 }
 
@@ -676,7 +1051,76 @@ func (s *JSONStorage) DeleteWeight(id string) error {
 
 // Add to internal/storage/json.go
 
-func (s *JSONStorage) UpdateExercise(date time.Time, record models.ExerciseRecord) error {
+func (s *JSONStorage) UpdateExercise(id string, record models.ExerciseRecord) error {
+	return updateRecordByID(s, "exercise", id, record)
+}
+
+func (s *JSONStorage) DeleteExercise(id string) error {
+	return deleteRecordByID[models.ExerciseRecord](s, "exercise", id)
+}
+
+// AddGear stores gear under a new ID
+func (s *JSONStorage) AddGear(gear models.Gear) (models.Gear, error) {
+	filepath := s.getFilePath("gear")
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return gear, fmt.Errorf("failed to read gear file: %w", err)
+	}
+
+	var list models.GearList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return gear, fmt.Errorf("failed to parse gear data: %w", err)
+	}
+
+	gear.ID, err = allocateID(s, "gear", list)
+	if err != nil {
+		return gear, err
+	}
+	list = append(list, gear)
+
+	return gear, writeRecords(filepath, "gear", list)
+}
+
+// GetGear returns all gear, active and retired, in the order it was added
+func (s *JSONStorage) GetGear() (models.GearList, error) {
+	filepath := s.getFilePath("gear")
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gear file: %w", err)
+	}
+
+	var list models.GearList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse gear data: %w", err)
+	}
+
+	return list, nil
+}
+
+func (s *JSONStorage) GetGearByID(id string) (*models.Gear, error) {
+	return getRecordByID[models.Gear](s, "gear", id)
+}
+
+func (s *JSONStorage) UpdateGear(id string, gear models.Gear) error {
+	return updateRecordByID(s, "gear", id, gear)
+}
+
+// migrateOtherActivities moves exercise records logged as "other" with a
+// free-text name onto activity catalog entries, creating entries as needed.
+// A name the catalog can't take, such as "other" itself, is given a suffix;
+// records whose name still can't be added are left as they were rather than
+// failing start-up.
+func (s *JSONStorage) migrateOtherActivities() error {
 	filepath := s.getFilePath("exercise")
 	lock := s.getLock(filepath)
 
@@ -693,34 +1137,67 @@ func (s *JSONStorage) UpdateExercise(date time.Time, record models.ExerciseRecor
 		return fmt.Errorf("failed to parse exercise data: %w", err)
 	}
 
-	// Find and update the record
-	updated := false
+	settings, err := s.GetSettings()
+	if err != nil {
+		return err
+	}
+	catalog := settings.GetActivityCatalog()
+
+	migrated := false
 	for i := range records {
-		if records[i].Date.Equal(date) {
-			records[i] = record
-			updated = true
-			break
+		if records[i].Activity != models.Other || records[i].OtherActivity == "" {
+			continue
+		}
+		activity := catalog.Find(records[i].OtherActivity)
+		if activity == nil {
+			activity = addMigratedActivity(&catalog, records[i])
+		}
+		if activity == nil {
+			continue
 		}
+		records[i].Activity = activity.Name
+		records[i].OtherActivity = ""
+		migrated = true
 	}
 
-	if !updated {
-		return fmt.Errorf("record not found for date: %s", date.Format(validator.DateFormat))
+	if !migrated {
+		return nil
 	}
 
-	// Write back to file
-	updatedData, err := json.MarshalIndent(records, "", "    ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal exercise data: %w", err)
+	settings.Activities = catalog
+	if err := s.SaveSettings(settings); err != nil {
+		return err
 	}
+	return writeRecords(filepath, "exercise", records)
+}
 
-	if err := os.WriteFile(filepath, updatedData, 0644); err != nil {
-		return fmt.Errorf("failed to write exercise file: %w", err)
+// addMigratedActivity adds a catalog entry for a legacy "other" record, with
+// the category guessed from its name and distance. It returns nil when
+// neither the name nor the suffixed name can be added.
+func addMigratedActivity(catalog *models.ActivityCatalog, record models.ExerciseRecord) *models.Activity {
+	category := models.GuessActivityCategory(record.OtherActivity)
+	if record.Distance > 0 {
+		category = models.CategoryCardio
 	}
 
+	name := models.NormalizeActivityName(record.OtherActivity)
+	if name == "" {
+		return nil
+	}
+	for _, candidate := range []models.ActivityType{name, name + "_activity"} {
+		if existing := catalog.Find(string(candidate)); existing != nil {
+			return existing
+		}
+		if err := catalog.Add(models.Activity{Name: candidate, Category: category}); err == nil {
+			return catalog.Find(string(candidate))
+		}
+	}
 	return nil
 }
 
-func (s *JSONStorage) DeleteExercise(date time.Time) error {
+// RenameExerciseActivity moves every record of one activity to another name
+// and returns how many records changed
+func (s *JSONStorage) RenameExerciseActivity(from, to models.ActivityType) (int, error) {
 	filepath := s.getFilePath("exercise")
 	lock := s.getLock(filepath)
 
@@ -729,36 +1206,63 @@ func (s *JSONStorage) DeleteExercise(date time.Time) error {
 
 	data, err := os.ReadFile(filepath)
 	if err != nil {
-		return fmt.Errorf("failed to read exercise file: %w", err)
+		return 0, fmt.Errorf("failed to read exercise file: %w", err)
 	}
 
 	var records []models.ExerciseRecord
 	if err := json.Unmarshal(data, &records); err != nil {
-		return fmt.Errorf("failed to parse exercise data: %w", err)
+		return 0, fmt.Errorf("failed to parse exercise data: %w", err)
 	}
 
-	// Find and remove the record
-	newRecords := make([]models.ExerciseRecord, 0, len(records))
-	found := false
-	for _, record := range records {
-		if record.Date.Equal(date) {
-			found = true
-			continue
+	count := 0
+	for i := range records {
+		if records[i].Activity == from {
+			records[i].Activity = to
+			count++
 		}
-		newRecords = append(newRecords, record)
 	}
 
-	if !found {
-		return fmt.Errorf("record not found for date: %s", date.Format(validator.DateFormat))
+	if count == 0 {
+		return 0, nil
 	}
+	return count, writeRecords(filepath, "exercise", records)
+}
 
-	updatedData, err := json.MarshalIndent(newRecords, "", "    ")
+// Settings implementations
+func (s *JSONStorage) GetSettings() (models.Settings, error) {
+	filepath := s.getFilePath("settings")
+	lock := s.getLock(filepath)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	var settings models.Settings
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return settings, fmt.Errorf("failed to read settings file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("failed to parse settings data: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *JSONStorage) SaveSettings(settings models.Settings) error {
+	filepath := s.getFilePath("settings")
+	lock := s.getLock(filepath)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	updatedData, err := json.MarshalIndent(settings, "", "    ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal exercise data: %w", err)
+		return fmt.Errorf("failed to marshal settings data: %w", err)
 	}
 
 	if err := os.WriteFile(filepath, updatedData, 0644); err != nil {
-		return fmt.Errorf("failed to write exercise file: %w", err)
+		return fmt.Errorf("failed to write settings file: %w", err)
 	}
 
 	return nil
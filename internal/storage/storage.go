@@ -13,6 +13,10 @@ type StorageManager interface {
 	IsTestMode() bool
 	GetDataDir() string
 
+	// Settings
+	GetSettings() (models.Settings, error)
+	SaveSettings(models.Settings) error
+
 	// Weight records
 	AddWeight(models.WeightRecord) (models.WeightRecord, error)
 	GetWeight(time.Time) (*models.WeightRecord, error)
@@ -25,19 +29,47 @@ type StorageManager interface {
 	DeleteWeight(id string) error
 
 	// Exercise records
-	AddExercise(models.ExerciseRecord) error
-	GetExercise(time.Time) (*models.ExerciseRecord, error)
+	AddExercise(models.ExerciseRecord) (models.ExerciseRecord, error)
 	GetExerciseRange(start, end time.Time, isDefaultRange bool) ([]models.ExerciseRecord, error)
-	UpdateExercise(date time.Time, record models.ExerciseRecord) error
-	DeleteExercise(date time.Time) error
+	GetExerciseByID(id string) (*models.ExerciseRecord, error)
+	UpdateExercise(id string, record models.ExerciseRecord) error
+	DeleteExercise(id string) error
+	RenameExerciseActivity(from, to models.ActivityType) (int, error)
+
+	// Gear, with usage accumulated from linked exercise records
+	AddGear(models.Gear) (models.Gear, error)
+	GetGear() (models.GearList, error)
+	GetGearByID(id string) (*models.Gear, error)
+	UpdateGear(id string, gear models.Gear) error
 
 	// Fasting records
 	AddFasting(models.FastingRecord) error
 	GetFasting(time.Time) (*models.FastingRecord, error)
 	GetFastingRange(start, end time.Time, isDefaultRange bool) ([]models.FastingRecord, error)
+	UpdateFasting(date time.Time, record models.FastingRecord) error
+	DeleteFasting(date time.Time) error
+
+	// Fasting windows (timed fasts)
+	AddFastingWindow(models.FastingWindow) error
+	GetActiveFastingWindow() (*models.FastingWindow, error)
+	GetFastingWindowRange(start, end time.Time) ([]models.FastingWindow, error)
+	UpdateFastingWindow(start time.Time, window models.FastingWindow) error
+	DeleteFastingWindow(start time.Time) error
+
+	// Meal entries
+	AddMeal(models.MealEntry) error
+	GetMealRange(start, end time.Time) ([]models.MealEntry, error)
+	DeleteMeal(at time.Time) error
 
 	// Soda records
 	AddSoda(models.SodaRecord) error
 	GetSoda(time.Time) (*models.SodaRecord, error)
 	GetSodaRange(start, end time.Time, isDefaultRange bool) ([]models.SodaRecord, error)
+	UpdateSoda(date time.Time, record models.SodaRecord) error
+	DeleteSoda(date time.Time) error
+
+	// Soda entries (individual drinks)
+	AddSodaEntry(models.SodaEntry) error
+	GetSodaEntryRange(start, end time.Time) ([]models.SodaEntry, error)
+	DeleteSodaEntry(at time.Time) error
 }
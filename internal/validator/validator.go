@@ -7,12 +7,33 @@ import (
 )
 
 const (
-	DateFormat    = "2006-01-02" // Go's reference date format for YYYY-MM-DD
-	MaxNoteLength = 500          // Maximum characters for notes
+	DateFormat     = "2006-01-02"       // Go's reference date format for YYYY-MM-DD
+	TimeFormat     = "15:04"            // 24-hour clock time HH:MM
+	DateTimeFormat = "2006-01-02 15:04" // YYYY-MM-DD HH:MM
+	MaxNoteLength  = 500                // Maximum characters for notes
 )
 
 // ParseDate converts string to time.Time and validates format
 func ParseDate(date string) (time.Time, error) {
+	parsedDate, err := ParseDateAllowingFuture(date)
+	if err != nil || date == "" {
+		return parsedDate, err
+	}
+
+	// Don't allow future dates. Parsed dates are midnight UTC, so they're
+	// compared with today's local date rather than the current instant.
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if parsedDate.After(today) {
+		return time.Time{}, fmt.Errorf("future dates are not allowed")
+	}
+
+	return parsedDate, nil
+}
+
+// ParseDateAllowingFuture parses a date like ParseDate but accepts future
+// dates, for scheduling ahead
+func ParseDateAllowingFuture(date string) (time.Time, error) {
 	if date == "" {
 		return time.Now(), nil // Default to current date
 	}
@@ -22,12 +43,31 @@ func ParseDate(date string) (time.Time, error) {
 		return time.Time{}, fmt.Errorf("invalid date format. Use YYYY-MM-DD")
 	}
 
-	// Don't allow future dates
-	if parsedDate.After(time.Now()) {
-		return time.Time{}, fmt.Errorf("future dates are not allowed")
+	return parsedDate, nil
+}
+
+// ParseDateTime converts "YYYY-MM-DD HH:MM" or "HH:MM" (today) to a local time
+func ParseDateTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now(), nil // Default to now
 	}
 
-	return parsedDate, nil
+	parsed, err := time.ParseInLocation(DateTimeFormat, value, time.Local)
+	if err != nil {
+		clock, clockErr := time.ParseInLocation(TimeFormat, value, time.Local)
+		if clockErr != nil {
+			return time.Time{}, fmt.Errorf("invalid time format. Use HH:MM or \"YYYY-MM-DD HH:MM\"")
+		}
+		now := time.Now()
+		parsed = time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, time.Local)
+	}
+
+	// Don't allow future times
+	if parsed.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("future times are not allowed")
+	}
+
+	return parsed, nil
 }
 
 // ValidateNotes checks the notes field
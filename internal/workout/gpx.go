@@ -0,0 +1,58 @@
+// internal/workout/gpx.go
+package workout
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type gpxFile struct {
+	Tracks []struct {
+		Type     string `xml:"type"`
+		Segments []struct {
+			Points []struct {
+				Lat       float64  `xml:"lat,attr"`
+				Lon       float64  `xml:"lon,attr"`
+				Elevation *float64 `xml:"ele"`
+				Time      string   `xml:"time"`
+			} `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// parseGPX reads the track points of every track in a GPX file. The activity
+// comes from the first track's type, when the device recorded one.
+func parseGPX(data []byte) (*Workout, error) {
+	var file gpxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse GPX file: %w", err)
+	}
+
+	var points []Point
+	for _, track := range file.Tracks {
+		for _, segment := range track.Segments {
+			for _, p := range segment.Points {
+				point := Point{Lat: p.Lat, Lon: p.Lon, Elevation: p.Elevation}
+				if p.Time != "" {
+					t, err := time.Parse(time.RFC3339, strings.TrimSpace(p.Time))
+					if err != nil {
+						return nil, fmt.Errorf("invalid GPX track point time: %s", p.Time)
+					}
+					point.Time = t
+				}
+				points = append(points, point)
+			}
+		}
+	}
+
+	w, err := fromPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Tracks) > 0 {
+		w.Sport = strings.TrimSpace(file.Tracks[0].Type)
+	}
+	return w, nil
+}
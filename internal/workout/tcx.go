@@ -0,0 +1,86 @@
+// internal/workout/tcx.go
+package workout
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+)
+
+type tcxFile struct {
+	Activities []struct {
+		Sport string `xml:"Sport,attr"`
+		Laps  []struct {
+			TotalTimeSeconds float64 `xml:"TotalTimeSeconds"`
+			DistanceMeters   float64 `xml:"DistanceMeters"`
+			Points           []struct {
+				Time     string `xml:"Time"`
+				Position *struct {
+					Lat float64 `xml:"LatitudeDegrees"`
+					Lon float64 `xml:"LongitudeDegrees"`
+				} `xml:"Position"`
+				Altitude *float64 `xml:"AltitudeMeters"`
+			} `xml:"Track>Trackpoint"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+// parseTCX reads the first activity in a TCX file. Lap totals recorded by the
+// device are preferred over values computed from the track points, since
+// indoor sessions have no positions.
+func parseTCX(data []byte) (*Workout, error) {
+	var file tcxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse TCX file: %w", err)
+	}
+	if len(file.Activities) == 0 {
+		return nil, fmt.Errorf("TCX file has no activities")
+	}
+	activity := file.Activities[0]
+
+	var points, positioned []Point
+	var lapSeconds, lapMeters float64
+	for _, lap := range activity.Laps {
+		lapSeconds += lap.TotalTimeSeconds
+		lapMeters += lap.DistanceMeters
+		for _, p := range lap.Points {
+			point := Point{Elevation: p.Altitude}
+			if p.Time != "" {
+				t, err := time.Parse(time.RFC3339, strings.TrimSpace(p.Time))
+				if err != nil {
+					return nil, fmt.Errorf("invalid TCX track point time: %s", p.Time)
+				}
+				point.Time = t
+			}
+			points = append(points, point)
+			if p.Position != nil {
+				point.Lat, point.Lon = p.Position.Lat, p.Position.Lon
+				positioned = append(positioned, point)
+			}
+		}
+	}
+
+	// Positions give distance; without them only elevation can be taken from the points
+	track := positioned
+	if len(track) < 2 {
+		track = points
+	}
+	w, err := fromPoints(track)
+	if err != nil {
+		return nil, err
+	}
+	if len(positioned) < 2 {
+		w.Distance = 0
+	}
+	if lapSeconds > 0 {
+		w.Duration = time.Duration(lapSeconds * float64(time.Second))
+	}
+	if lapMeters > 0 {
+		w.Distance = models.Kilometers.ToMiles(lapMeters / 1000)
+	}
+	w.Sport = activity.Sport
+	return w, nil
+}
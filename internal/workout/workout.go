@@ -0,0 +1,131 @@
+// internal/workout/workout.go
+package workout
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jack-sneddon/my-health-tracker/internal/models"
+)
+
+const (
+	earthRadiusMeters = 6371000.0
+
+	// elevationThreshold is the climb in meters needed before gain is counted,
+	// so GPS altitude noise on flat ground doesn't add up to phantom climbing
+	elevationThreshold = 3.0
+)
+
+// Point is one recorded position on a track
+type Point struct {
+	Time      time.Time
+	Lat, Lon  float64
+	Elevation *float64 // meters, when recorded
+}
+
+// Workout is a recorded session read from a GPX or TCX file
+type Workout struct {
+	Sport         string // activity type named in the file, if any
+	Start         time.Time
+	Duration      time.Duration
+	Distance      float64 // in miles
+	ElevationGain float64 // in feet
+	Hash          string  // SHA-256 of the file contents
+}
+
+// Minutes returns the duration rounded to whole minutes
+func (w Workout) Minutes() int {
+	return int(math.Round(w.Duration.Minutes()))
+}
+
+// ParseFile reads a GPX or TCX file, choosing the format from its extension
+func ParseFile(path string) (*Workout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workout file: %w", err)
+	}
+
+	var w *Workout
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".gpx":
+		w, err = parseGPX(data)
+	case ".tcx":
+		w, err = parseTCX(data)
+	default:
+		return nil, fmt.Errorf("unsupported workout file type: %s (use .gpx or .tcx)", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	w.Hash = hex.EncodeToString(sum[:])
+	return w, nil
+}
+
+// fromPoints computes start time, duration, distance and elevation gain from
+// a track's points
+func fromPoints(points []Point) (*Workout, error) {
+	var timed []Point
+	for _, p := range points {
+		if !p.Time.IsZero() {
+			timed = append(timed, p)
+		}
+	}
+	if len(timed) < 2 {
+		return nil, fmt.Errorf("workout file has fewer than two timed track points")
+	}
+	sort.SliceStable(timed, func(i, j int) bool { return timed[i].Time.Before(timed[j].Time) })
+
+	meters := 0.0
+	for i := 1; i < len(timed); i++ {
+		meters += haversine(timed[i-1], timed[i])
+	}
+
+	return &Workout{
+		Start:         timed[0].Time,
+		Duration:      timed[len(timed)-1].Time.Sub(timed[0].Time),
+		Distance:      models.Kilometers.ToMiles(meters / 1000),
+		ElevationGain: models.Kilometers.ToFeet(elevationGain(timed)),
+	}, nil
+}
+
+// haversine returns the great-circle distance between two points in meters
+func haversine(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// elevationGain totals climbing in meters, only counting a climb once it
+// exceeds elevationThreshold above the last low point
+func elevationGain(points []Point) float64 {
+	gain := 0.0
+	var base *float64
+	for _, p := range points {
+		if p.Elevation == nil {
+			continue
+		}
+		elevation := *p.Elevation
+		switch {
+		case base == nil || elevation < *base:
+			base = &elevation
+		case elevation-*base >= elevationThreshold:
+			gain += elevation - *base
+			base = &elevation
+		}
+	}
+	return gain
+}
@@ -0,0 +1,75 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "activity"
+
+# Test 1: Default catalog
+echo -e "\n${YELLOW}Test 1: Default catalog${NC}"
+output=$(TEST_MODE=true ./bin/tracker activity list 2>&1)
+assert_output_contains "$output" "jogging" "Default activities listed"
+assert_output_contains "$output" "sport" "Categories listed"
+
+# Test 2: Add an activity
+echo -e "\n${YELLOW}Test 2: Add activity${NC}"
+output=$(TEST_MODE=true ./bin/tracker activity add "Rock Climbing" --category strength --alias climb 2>&1)
+assert_output_contains "$output" "Activity added successfully" "Activity added"
+assert_output_contains "$output" "rock_climbing" "Name normalized"
+output=$(TEST_MODE=true ./bin/tracker activity add climbing --category sport --alias climb 2>&1)
+assert_output_contains "$output" "already used" "Alias collision rejected"
+output=$(TEST_MODE=true ./bin/tracker activity add yoga --category stretch 2>&1)
+assert_output_contains "$output" "invalid activity category" "Invalid category rejected"
+
+# Test 3: Rename moves records
+echo -e "\n${YELLOW}Test 3: Rename${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity climb --duration 60 --date 2024-01-08
+output=$(TEST_MODE=true ./bin/tracker activity rename rock_climbing bouldering 2>&1)
+assert_output_contains "$output" "1 exercise record(s) updated" "Records moved"
+output=$(TEST_MODE=true ./bin/tracker exercise get e00001 2>&1)
+assert_output_contains "$output" "Activity:   bouldering" "Record uses new name"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity rock_climbing --duration 30 --date 2024-01-09 2>&1)
+assert_output_contains "$output" "Activity:   bouldering" "Old name still resolves"
+
+# Test 4: Archive
+echo -e "\n${YELLOW}Test 4: Archive${NC}"
+output=$(TEST_MODE=true ./bin/tracker activity archive skiing 2>&1)
+assert_output_contains "$output" "Activity archived" "Activity archived"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity skiing --duration 60 --date 2024-01-10 2>&1)
+assert_output_contains "$output" "is archived" "Archived activity rejected"
+output=$(TEST_MODE=true ./bin/tracker activity list --all 2>&1)
+assert_output_contains "$output" "archived" "Archived shown with --all"
+output=$(TEST_MODE=true ./bin/tracker activity archive skiing --restore 2>&1)
+assert_output_contains "$output" "Activity restored" "Activity restored"
+
+# Test 5: Migrate legacy other activities
+echo -e "\n${YELLOW}Test 5: Migrate legacy records${NC}"
+cleanup_test_data
+mkdir -p "$TEST_DATA_DIR"
+cat > "$TEST_DATA_DIR/exercise.json" <<'JSON'
+[
+    {"id": "e00001", "date": "2024-01-08T00:00:00Z", "activity": "other", "other_activity": "Swimming", "duration": 30, "completed": true},
+    {"id": "e00002", "date": "2024-01-09T00:00:00Z", "activity": "other", "other_activity": "swimming", "duration": 40, "completed": true}
+]
+JSON
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "By Activity" "Shows activity breakdown"
+assert_output_contains "$output" "swimming  cardio    2" "Legacy sessions grouped together"
+output=$(TEST_MODE=true ./bin/tracker activity list 2>&1)
+assert_output_contains "$output" "swimming" "Catalog entry created"
+
+# Test 6: Legacy names that clash with the catalog don't stop start-up
+echo -e "\n${YELLOW}Test 6: Clashing legacy names${NC}"
+cat > "$TEST_DATA_DIR/exercise.json" <<'JSON'
+[
+    {"id": "e00003", "date": "2024-01-10T00:00:00Z", "activity": "other", "other_activity": "other", "duration": 20, "completed": true},
+    {"id": "e00004", "date": "2024-01-11T00:00:00Z", "activity": "other", "other_activity": "Tennis", "duration": 60, "completed": true},
+    {"id": "e00005", "date": "2024-01-12T00:00:00Z", "activity": "other", "other_activity": "-", "duration": 15, "completed": true}
+]
+JSON
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_not_contains "$output" "failed to migrate" "Start-up not blocked"
+assert_output_contains "$output" "other_activity  cardio    1" "Reserved name given a suffix"
+assert_output_contains "$output" "tennis          sport     1" "Category guessed from the name"
+
+show_test_summary
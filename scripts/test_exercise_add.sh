@@ -11,10 +11,10 @@ output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration
 assert_output_contains "$output" "Exercise record added successfully" "Record was added"
 verify_exercise_file
 
-# Test 2: Invalid other activity
-echo -e "\n${YELLOW}Test 2: Invalid other activity${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise add --activity other --duration 30 --date 2024-01-09 2>&1)
-assert_output_contains "$output" "other-activity flag is required" "Validation failed correctly"
+# Test 2: Activity missing from the catalog
+echo -e "\n${YELLOW}Test 2: Activity missing from the catalog${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity swimming --duration 30 --date 2024-01-09 2>&1)
+assert_output_contains "$output" "tracker activity add" "Validation failed correctly"
 
 # Test 3: Invalid activity type
 echo -e "\n${YELLOW}Test 3: Invalid activity type${NC}"
@@ -37,16 +37,37 @@ assert_output_contains "$cycling_record" "cycling" "Activity type is correct"
 assert_output_contains "$cycling_record" "60" "Duration is correct"
 assert_output_contains "$cycling_record" "true" "Completed flag is set"
 
-# Test 6: Add with other activity type
-echo -e "\n${YELLOW}Test 6: Add other activity${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise add --activity other --other-activity "swimming" --duration 30 --date 2024-01-13 2>&1)
-assert_output_contains "$output" "Exercise record added successfully" "Other activity record was added"
+# Test 6: Add catalog activity by alias
+echo -e "\n${YELLOW}Test 6: Add catalog activity by alias${NC}"
+TEST_MODE=true ./bin/tracker activity add swimming --category cardio --alias swim
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity swim --duration 30 --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Exercise record added successfully" "Catalog activity record was added"
 verify_exercise_file
 # Verify specific record content
 swimming_record=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-13 2>&1)
-assert_output_contains "$swimming_record" "other" "Activity type is correct"
-assert_output_contains "$swimming_record" "swimming" "Other activity is specified"
+assert_output_contains "$swimming_record" "Activity:   swimming" "Alias resolved to catalog name"
 assert_output_contains "$swimming_record" "30" "Duration is correct"
 
+# Test 7: Second session on the same day
+echo -e "\n${YELLOW}Test 7: Second session on the same day${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity pickleball --duration 30 --date 2024-01-08 --completed 2>&1)
+assert_output_contains "$output" "Exercise record added successfully" "Second session was added"
+assert_output_contains "$output" "75 minutes across 2 session(s)" "Day total includes both sessions"
+day_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
+assert_output_contains "$day_check" "jogging" "First session listed"
+assert_output_contains "$day_check" "pickleball" "Second session listed"
+id_check=$(TEST_MODE=true ./bin/tracker exercise get e00004 2>&1)
+assert_output_contains "$id_check" "pickleball" "Session found by ID"
+
+# Test 8: Sessions logged today without --date share the day
+echo -e "\n${YELLOW}Test 8: Sessions logged today${NC}"
+today=$(date +%Y-%m-%d)
+TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 20 --completed > /dev/null
+TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 30 --completed > /dev/null
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 30 --completed 2>&1)
+assert_output_contains "$output" "80 minutes across 3 session(s)" "Today's sessions grouped together"
+day_check=$(TEST_MODE=true ./bin/tracker exercise get --date $today 2>&1)
+assert_output_not_contains "$day_check" "not found" "Today's sessions found by date"
+
 # Show results
 show_test_summary
\ No newline at end of file
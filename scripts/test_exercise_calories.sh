@@ -0,0 +1,51 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_calories"
+
+# Test 1: No weight recorded yet
+echo -e "\n${YELLOW}Test 1: No weight recorded${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2024-01-08 --intensity vigorous 2>&1)
+assert_output_contains "$output" "Exercise record added successfully" "Record added without weight"
+assert_output_contains "$output" "Intensity:  vigorous" "Intensity recorded"
+assert_output_contains "$output" "Calories unknown: log a weight" "Missing weight reported"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "Total Calories   : unknown (log a weight)" "Total not shown as zero"
+
+# Test 2: Estimate from closest weight
+echo -e "\n${YELLOW}Test 2: Estimate from closest weight${NC}"
+TEST_MODE=true ./bin/tracker weight add -v 185.5 --date 2024-01-09
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2024-01-10 --intensity vigorous 2>&1)
+assert_output_contains "$output" "Estimated 486 calories burned (vigorous, MET 11.0 at 185.5 lbs from 2024-01-09)" "Calories estimated"
+
+# Test 3: RPE maps to an intensity
+echo -e "\n${YELLOW}Test 3: RPE${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 60 --date 2024-01-10 --rpe 3 2>&1)
+assert_output_contains "$output" "Intensity:  RPE 3" "RPE recorded"
+assert_output_contains "$output" "(light, MET 2.8" "RPE 3 is light effort"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 60 --rpe 11 2>&1)
+assert_output_contains "$output" "RPE must be between 1 and 10" "Invalid RPE rejected"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 60 --rpe 5 --intensity light 2>&1)
+assert_output_contains "$output" "cannot use both" "Conflicting effort flags rejected"
+
+# Test 4: Catalog MET override
+echo -e "\n${YELLOW}Test 4: Catalog MET override${NC}"
+TEST_MODE=true ./bin/tracker activity add swimming --category cardio --mets 5,7,10
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity swimming --duration 30 --date 2024-01-10 2>&1)
+assert_output_contains "$output" "(moderate, MET 7.0" "Catalog METs used"
+
+# Test 5: List shows per-session and total calories
+echo -e "\n${YELLOW}Test 5: List calories${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "Calories" "Calories column shown"
+assert_output_contains "$output" "Total Calories" "Total calories shown"
+
+# Test 6: The weight used is shown in the preferred unit
+echo -e "\n${YELLOW}Test 6: Weight unit${NC}"
+TEST_MODE=true ./bin/tracker weight unit kg > /dev/null 2>&1
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2024-01-11 2>&1)
+assert_output_contains "$output" "at 84.1 kg from 2024-01-09" "Weight shown in kg"
+
+show_test_summary
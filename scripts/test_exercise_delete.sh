@@ -18,7 +18,7 @@ reset_test_data
 
 # Test 1: Delete existing record
 echo -e "\n${YELLOW}Test 1: Delete existing record${NC}"
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise delete --date 2024-01-08 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise delete e00001 2>&1)
 assert_output_contains "$output" "Exercise record deleted successfully" "Delete succeeded"
 verify_exercise_file
 
@@ -27,7 +27,7 @@ reset_test_data
 
 # Test 2: Delete cancelled
 echo -e "\n${YELLOW}Test 2: Delete cancelled${NC}"
-output=$(echo "n" | TEST_MODE=true ./bin/tracker exercise delete --date 2024-01-08 2>&1)
+output=$(echo "n" | TEST_MODE=true ./bin/tracker exercise delete e00001 2>&1)
 assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
 # Verify record still exists
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
@@ -35,12 +35,20 @@ assert_output_contains "$record_check" "jogging" "Record still exists"
 
 # Test 3: Delete non-existent record
 echo -e "\n${YELLOW}Test 3: Delete non-existent record${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise delete --date 2023-01-01 2>&1)
+output=$(TEST_MODE=true ./bin/tracker exercise delete e99999 2>&1)
 assert_output_contains "$output" "not found" "Shows not found message"
 
-# Test 4: Invalid date format
-echo -e "\n${YELLOW}Test 4: Invalid date format${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise delete --date "invalid" 2>&1)
-assert_output_contains "$output" "invalid date format" "Shows invalid date message"
+# Test 4: Invalid ID format
+echo -e "\n${YELLOW}Test 4: Invalid ID format${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise delete "invalid" 2>&1)
+assert_output_contains "$output" "invalid exercise record ID format" "Shows invalid ID message"
+
+# Test 5: Delete one of several sessions on a day
+echo -e "\n${YELLOW}Test 5: Delete one session of several${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity pickleball --duration 60 --date 2024-01-08 --completed
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise delete e00001 2>&1)
+assert_output_contains "$output" "Exercise record deleted successfully" "Delete succeeded"
+record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
+assert_output_contains "$record_check" "pickleball" "Other session on the day remains"
 
 show_test_summary
\ No newline at end of file
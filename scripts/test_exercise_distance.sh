@@ -0,0 +1,53 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_distance"
+
+# Test 1: Distance and elevation
+echo -e "\n${YELLOW}Test 1: Distance and elevation${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 40 --distance 5 --elevation 300 --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Distance:   5.00 mi, 300 ft gain" "Distance recorded"
+assert_output_contains "$output" "Pace:       8:00 /mi (7.5 mph)" "Pace and speed computed"
+
+# Test 2: Kilometers are converted
+echo -e "\n${YELLOW}Test 2: Kilometers${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 60 --distance 32.19 --unit km --date 2024-01-09 2>&1)
+assert_output_contains "$output" "Distance:   20.00 mi" "Kilometers converted to miles"
+
+# Test 3: Implausible pace is flagged
+echo -e "\n${YELLOW}Test 3: Implausible pace${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 3 --distance 1 --date 2024-01-10 2>&1)
+assert_output_contains "$output" "faster than expected" "3-minute mile flagged"
+assert_output_contains "$output" "Operation cancelled" "Record not saved"
+
+# Test 4: Distance only for cardio
+echo -e "\n${YELLOW}Test 4: Distance only for cardio${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity pickleball --duration 60 --distance 2 --date 2024-01-10 2>&1)
+assert_output_contains "$output" "only tracked for cardio" "Sport distance rejected"
+
+# Test 5: Update distance
+echo -e "\n${YELLOW}Test 5: Update distance${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --distance 4 2>&1)
+assert_output_contains "$output" "Pace:       10:00 /mi" "Pace recomputed"
+
+# Test 6: List shows distance, pace and per-activity totals
+echo -e "\n${YELLOW}Test 6: List${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --distance 3 --date 2024-01-11
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "Pace" "Pace column shown"
+assert_output_contains "$output" "7.00 mi" "Jogging distance totalled"
+assert_output_contains "$output" "10:00 /mi" "Average pace per activity"
+
+# Test 7: List in kilometers
+echo -e "\n${YELLOW}Test 7: List in kilometers${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 --unit km 2>&1)
+assert_output_contains "$output" "32.19 km" "Cycling distance shown in km"
+assert_output_contains "$output" "11.27 km" "Jogging total shown in km"
+assert_output_contains "$output" "/km" "Pace shown per km"
+assert_output_not_contains "$output" "/mi" "No pace per mile shown"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-31 --unit yards 2>&1)
+assert_output_contains "$output" "yards" "Unknown unit rejected"
+
+show_test_summary
@@ -11,8 +11,9 @@ echo -e "\n${YELLOW}Setting up test data${NC}"
 echo "y" | TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 45 --date 2024-01-08 --notes "First exercise"
 # Add a completed exercise record
 echo "y" | TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 60 --date 2024-01-09 --completed --notes "Evening ride"
-# Add an 'other' activity record
-echo "y" | TEST_MODE=true ./bin/tracker exercise add --activity other --other-activity "swimming" --duration 30 --date 2024-01-10 --notes "Pool workout"
+# Add a user-defined activity record
+TEST_MODE=true ./bin/tracker activity add swimming --category cardio
+echo "y" | TEST_MODE=true ./bin/tracker exercise add --activity swimming --duration 30 --date 2024-01-10 --notes "Pool workout"
 verify_exercise_file
 
 # Test 1: Get basic record
@@ -31,10 +32,10 @@ assert_output_contains "$output" "60 minutes" "Shows correct duration"
 assert_output_contains "$output" "Evening ride" "Shows notes"
 assert_output_contains "$output" "Completed:  true" "Shows completed status"
 
-# Test 3: Get other activity record
-echo -e "\n${YELLOW}Test 3: Get other activity record${NC}"
+# Test 3: Get user-defined activity record
+echo -e "\n${YELLOW}Test 3: Get user-defined activity record${NC}"
 output=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-10 2>&1)
-assert_output_contains "$output" "other (swimming)" "Shows other activity type with details"
+assert_output_contains "$output" "Activity:   swimming" "Shows user-defined activity"
 assert_output_contains "$output" "30 minutes" "Shows correct duration"
 assert_output_contains "$output" "Pool workout" "Shows notes"
 
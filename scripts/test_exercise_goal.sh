@@ -0,0 +1,64 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_goal"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 40 --date 2024-01-02 --completed
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 40 --date 2024-01-08 --intensity vigorous --completed
+TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 60 --date 2024-01-09 --intensity light --completed
+TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 50 --date 2024-01-10 --completed
+
+# Test 1: Default goal
+echo -e "\n${YELLOW}Test 1: Default goal${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise goal show 2>&1)
+assert_output_contains "$output" "Daily:              45 minutes" "Shows default daily goal"
+
+# Test 2: Set goals from a date
+echo -e "\n${YELLOW}Test 2: Set goals from a date${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise goal set --daily 30 --weekly 150 --weekly-intensity moderate --sessions 3 --target run=60 --from 2024-01-08 2>&1)
+assert_output_contains "$output" "Exercise goals updated from 2024-01-08" "Goals saved"
+assert_output_contains "$output" "jogging:            60 minutes per week" "Target resolved from alias"
+
+# Test 3: Daily compliance uses the goal in force
+echo -e "\n${YELLOW}Test 3: Daily compliance uses the goal in force${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-02 2>&1)
+assert_output_contains "$output" "5 more completed minutes needed for the 45 minute goal" "Earlier day judged on old goal"
+output=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "goal met" "Later day judged on new goal"
+
+# Test 4: Weekly progress in list
+echo -e "\n${YELLOW}Test 4: Weekly progress in list${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-01 --to 2024-01-14 2>&1)
+assert_output_contains "$output" "90/150" "Light minutes excluded from weekly minutes"
+assert_output_contains "$output" "3/3" "Counts sessions per week"
+assert_output_contains "$output" "jogging 40/60" "Reports activity target"
+assert_output_contains "$output" "Weeks On Target  : 0 of 1" "Weeks before the goal are not tracked"
+assert_output_contains "$output" "Compliant Days   : 3" "Compliant days use the goal in force"
+
+# Test 5: A week without sessions is off target
+echo -e "\n${YELLOW}Test 5: Empty week${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 40 --date 2024-01-22 --completed > /dev/null
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-08 --to 2024-01-28 2>&1)
+assert_output_contains "$output" "2024-01-15  0/150" "Empty week listed"
+assert_output_contains "$output" "Weeks On Target  : 0 of 3" "Empty week counted as off target"
+
+# Test 6: Weekly progress in the default range covers the requested weeks
+echo -e "\n${YELLOW}Test 6: Default range weeks${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 40 --date 2023-01-10 --completed > /dev/null
+output=$(TEST_MODE=true ./bin/tracker exercise list 2>&1)
+assert_output_contains "$output" "2023-01-10" "Earlier year's session listed"
+assert_output_not_contains "$output" "2023-01-09  " "No weeks from the earlier year"
+assert_output_contains "$output" "Weeks On Target  : 0 of 4" "Only the requested weeks counted"
+
+# Test 7: Invalid goals
+echo -e "\n${YELLOW}Test 7: Invalid goals${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise goal set --daily -5 2>&1)
+assert_output_contains "$output" "goals cannot be negative" "Negative goal rejected"
+output=$(TEST_MODE=true ./bin/tracker exercise goal set --target swimming=30 2>&1)
+assert_output_contains "$output" "invalid activity type" "Unknown activity target rejected"
+
+show_test_summary
@@ -0,0 +1,87 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_import"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+WORKOUT_DIR=$(mktemp -d)
+trap 'rm -rf "$WORKOUT_DIR"' EXIT
+cat > "$WORKOUT_DIR/run.gpx" <<'GPX'
+<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <name>Morning Run</name>
+    <type>running</type>
+    <trkseg>
+      <trkpt lat="40.0000" lon="-105.0000"><ele>100</ele><time>2024-01-08T14:00:00Z</time></trkpt>
+      <trkpt lat="40.01447" lon="-105.0000"><ele>110</ele><time>2024-01-08T14:09:00Z</time></trkpt>
+      <trkpt lat="40.02894" lon="-105.0000"><ele>105</ele><time>2024-01-08T14:18:00Z</time></trkpt>
+      <trkpt lat="40.04341" lon="-105.0000"><ele>120</ele><time>2024-01-08T14:27:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>
+GPX
+cat > "$WORKOUT_DIR/ride.tcx" <<'TCX'
+<?xml version="1.0" encoding="UTF-8"?>
+<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+  <Activities>
+    <Activity Sport="Biking">
+      <Id>2024-01-09T15:00:00Z</Id>
+      <Lap StartTime="2024-01-09T15:00:00Z">
+        <TotalTimeSeconds>3600</TotalTimeSeconds>
+        <DistanceMeters>24140</DistanceMeters>
+        <Track>
+          <Trackpoint><Time>2024-01-09T15:00:00Z</Time><AltitudeMeters>200</AltitudeMeters></Trackpoint>
+          <Trackpoint><Time>2024-01-09T16:00:00Z</Time><AltitudeMeters>250</AltitudeMeters></Trackpoint>
+        </Track>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>
+TCX
+sed 's/<type>running<\/type>//' "$WORKOUT_DIR/run.gpx" > "$WORKOUT_DIR/untyped.gpx"
+
+# Test 1: Dry run previews without saving
+echo -e "\n${YELLOW}Test 1: Dry run previews without saving${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/run.gpx" --dry-run 2>&1)
+assert_output_contains "$output" "Dry run: record not saved" "Dry run reported"
+assert_output_contains "$output" "Activity:   jogging" "Activity taken from the file"
+assert_output_contains "$output" "Duration:   27 minutes" "Duration computed from the track"
+assert_output_contains "$output" "3.00 mi, 82 ft gain" "Distance and elevation computed from the track"
+output=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "not found" "Dry run saved nothing"
+
+# Test 2: Import a GPX file
+echo -e "\n${YELLOW}Test 2: Import a GPX file${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/run.gpx" 2>&1)
+assert_output_contains "$output" "Imported run.gpx" "GPX imported"
+assert_output_contains "$output" "Pace:       9:00 /mi" "Pace computed"
+
+# Test 3: Re-import is a duplicate
+echo -e "\n${YELLOW}Test 3: Re-import is a duplicate${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/run.gpx" 2>&1)
+assert_output_contains "$output" "run.gpx was already imported as e00001" "Duplicate import rejected"
+
+# Test 4: Import a TCX file using lap totals
+echo -e "\n${YELLOW}Test 4: Import a TCX file using lap totals${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/ride.tcx" 2>&1)
+assert_output_contains "$output" "Activity:   cycling" "Device sport mapped to catalog"
+assert_output_contains "$output" "Duration:   60 minutes" "Duration from lap totals"
+assert_output_contains "$output" "15.00 mi, 164 ft gain" "Distance from lap totals"
+
+# Test 5: Activity from flag
+echo -e "\n${YELLOW}Test 5: Activity from flag${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/untyped.gpx" 2>&1)
+assert_output_contains "$output" "use --activity" "Missing activity reported"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$WORKOUT_DIR/untyped.gpx" --activity walk --dry-run 2>&1)
+assert_output_contains "$output" "Activity:   walking" "Activity taken from flag"
+
+# Test 6: Unsupported file
+echo -e "\n${YELLOW}Test 6: Unsupported file${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise import "$TEST_DATA_DIR/exercise.json" 2>&1)
+assert_output_contains "$output" "unsupported workout file type" "Unsupported extension rejected"
+
+show_test_summary
@@ -20,7 +20,7 @@ reset_test_data
 echo -e "\n${YELLOW}Test 1: Update activity${NC}"
 echo -e "\n${YELLOW}Before update:${NC}"
 TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --activity cycling 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --activity cycling 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 echo -e "\n${YELLOW}After update:${NC}"
 verify_exercise_file
@@ -34,7 +34,7 @@ reset_test_data
 echo -e "\n${YELLOW}Test 2: Update duration${NC}"
 echo -e "\n${YELLOW}Before update:${NC}"
 TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --duration 60 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --duration 60 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 echo -e "\n${YELLOW}After update:${NC}"
 verify_exercise_file
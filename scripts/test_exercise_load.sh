@@ -0,0 +1,57 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_load"
+
+# Setup test data: four steady weeks of 30 moderate minutes three times a week
+echo -e "\n${YELLOW}Setting up test data${NC}"
+for day in 01 03 05 08 10 12 15 17 19 22 24 26; do
+    TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2024-01-$day --completed > /dev/null
+done
+
+# Test 1: Steady training is in the optimal zone
+echo -e "\n${YELLOW}Test 1: Steady training is in the optimal zone${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise load --date 2024-01-28 2>&1)
+assert_output_contains "$output" "Acute Load  : 450 AU" "Acute load is duration times RPE"
+assert_output_contains "$output" "Chronic Load: 450 AU per week" "Chronic load is the weekly average"
+assert_output_contains "$output" "Ratio       : 1.00 (optimal)" "Ratio in optimal zone"
+assert_output_not_contains "$output" "Warning" "No warnings for steady load"
+
+# Test 2: Short history is flagged
+echo -e "\n${YELLOW}Test 2: Short history is flagged${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise load --date 2024-01-10 2>&1)
+assert_output_contains "$output" "day(s) of history" "Short history reported"
+
+# Test 3: A sharp ramp warns of injury risk
+echo -e "\n${YELLOW}Test 3: A sharp ramp warns of injury risk${NC}"
+for day in 29 30 31; do
+    TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 90 --rpe 8 --date 2024-01-$day --completed > /dev/null
+done
+output=$(TEST_MODE=true ./bin/tracker exercise load --date 2024-02-04 2>&1)
+assert_output_contains "$output" "(high risk)" "Ratio in high risk zone"
+assert_output_contains "$output" "raising injury risk" "Injury risk warning shown"
+assert_output_contains "$output" "Ratio jumped from 1.00" "Week-on-week jump reported"
+
+# Test 4: Invalid input
+echo -e "\n${YELLOW}Test 4: Invalid input${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise load --date 2023-01-01 2>&1)
+assert_output_contains "$output" "No completed exercise sessions found" "No history reported"
+output=$(TEST_MODE=true ./bin/tracker exercise load --weeks 0 2>&1)
+assert_output_contains "$output" "weeks must be between 1 and 52" "Invalid weeks rejected"
+
+# Test 5: A long break leaves no chronic load
+echo -e "\n${YELLOW}Test 5: No chronic load${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise load --date 2024-04-01 2>&1)
+assert_output_contains "$output" "no chronic load to compare against" "Missing chronic load reported"
+assert_output_not_contains "$output" "day(s) of history" "Not reported as short history"
+
+# Test 6: Sessions logged today count toward acute load
+echo -e "\n${YELLOW}Test 6: Sessions logged today${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 50 --completed > /dev/null
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --completed > /dev/null
+output=$(TEST_MODE=true ./bin/tracker exercise load 2>&1)
+assert_output_contains "$output" "Acute Load  : 400 AU" "Today's sessions included"
+
+show_test_summary
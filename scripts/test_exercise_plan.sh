@@ -0,0 +1,74 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_plan"
+
+# Setup test data: two planned sessions in a past week, one unplanned session
+echo -e "\n${YELLOW}Setting up test data${NC}"
+TEST_MODE=true ./bin/tracker exercise plan add --activity jogging --duration 45 --distance 5 --date 2024-01-08 > /dev/null
+TEST_MODE=true ./bin/tracker exercise plan add --activity jogging --duration 60 --date 2024-01-10 > /dev/null
+TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 30 --date 2024-01-12 --completed > /dev/null
+
+# Test 1: Sessions can be planned for future dates
+echo -e "\n${YELLOW}Test 1: Plan a future session${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise plan add --activity jogging --duration 30 --date 2099-01-01 2>&1)
+assert_output_contains "$output" "Session planned for 2099-01-01" "Future session planned"
+assert_output_contains "$output" "30 minutes (planned)" "Plan details shown"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2099-01-01 2>&1)
+assert_output_contains "$output" "future" "Unplanned sessions still can't be in the future"
+
+# Test 2: Marking a plan done records the actual session
+echo -e "\n${YELLOW}Test 2: Mark a planned session done${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise plan done e00001 --duration 40 --distance 4.5 2>&1)
+assert_output_contains "$output" "Planned session marked done" "Plan marked done"
+assert_output_contains "$output" "40 of 45 planned minutes" "Plan versus actual minutes reported"
+assert_output_contains "$output" "(done)" "Plan shown as done"
+output=$(TEST_MODE=true ./bin/tracker exercise plan done e00001 2>&1)
+assert_output_contains "$output" "already done" "Done plans can't be done again"
+output=$(TEST_MODE=true ./bin/tracker exercise plan done e00003 2>&1)
+assert_output_contains "$output" "was not a planned session" "Unplanned sessions rejected"
+output=$(TEST_MODE=true ./bin/tracker exercise plan done e00004 2>&1)
+assert_output_contains "$output" "use --date to record when it was done" "Future plans need a date to be done"
+
+# Test 3: Plan list shows upcoming and missed sessions
+echo -e "\n${YELLOW}Test 3: List upcoming and missed sessions${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise plan list 2>&1)
+assert_output_contains "$output" "Upcoming Sessions" "Upcoming sessions listed"
+assert_output_contains "$output" "2099-01-01" "Future plan is upcoming"
+assert_output_contains "$output" "Missed Sessions" "Missed sessions listed"
+assert_output_contains "$output" "2024-01-10" "Past plan is missed"
+
+# Test 4: Missed plans count against compliance
+echo -e "\n${YELLOW}Test 4: Missed plans count against compliance${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-10 2>&1)
+assert_output_contains "$output" "1 planned session(s) missed" "Missed day is not compliant"
+output=$(TEST_MODE=true ./bin/tracker exercise list --from 2024-01-08 --to 2024-01-14 2>&1)
+assert_output_contains "$output" "missed" "Missed plan shown in list"
+assert_output_contains "$output" "Total Duration   : 70 minutes" "Missed plan adds no minutes"
+
+# Test 5: The report compares plan with actual per week
+echo -e "\n${YELLOW}Test 5: Plan versus actual report${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise plan report --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "2024-01-08" "Planned week reported"
+assert_output_contains "$output" "50%" "Adherence is done over due plans"
+assert_output_contains "$output" "40/105" "Actual versus planned minutes reported"
+
+# Test 6: Plans for past dates warn
+echo -e "\n${YELLOW}Test 6: Past plan warning${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise plan add --activity jogging --duration 30 --date 2024-02-01 2>&1)
+assert_output_contains "$output" "2024-02-01 is in the past" "Past plan date warned"
+
+# Test 7: Today's plan can be done in any time zone
+echo -e "\n${YELLOW}Test 7: Done on the planned day ahead of UTC${NC}"
+today=$(TZ=Pacific/Kiritimati date +%Y-%m-%d)
+output=$(TZ=Pacific/Kiritimati TEST_MODE=true ./bin/tracker exercise plan add --activity jogging --duration 30 --date $today 2>&1)
+assert_output_not_contains "$output" "in the past" "Today's plan not warned"
+plan_id=$(echo "$output" | grep -o "e[0-9]\{5\}" | head -1)
+output=$(TZ=Pacific/Kiritimati TEST_MODE=true ./bin/tracker exercise plan done $plan_id 2>&1)
+assert_output_contains "$output" "Planned session marked done" "Today's plan done without --date"
+output=$(TZ=Pacific/Kiritimati TEST_MODE=true ./bin/tracker exercise get --date $today 2>&1)
+assert_output_not_contains "$output" "future dates are not allowed" "Today's date accepted"
+
+show_test_summary
@@ -0,0 +1,38 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "exercise_records"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 45 --distance 5 --date 2024-01-08 --completed
+TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 60 --date 2024-01-10 --completed
+TEST_MODE=true ./bin/tracker exercise add --activity cycling --duration 90 --date 2024-01-11
+
+# Test 1: New best reported on add
+echo -e "\n${YELLOW}Test 1: New best reported on add${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 50 --distance 6 --date 2024-01-09 --completed 2>&1)
+assert_output_contains "$output" "New personal best! Longest Distance (jogging): 6.00 mi" "Distance best reported"
+assert_output_contains "$output" "New personal best! Fastest Pace (jogging): 8:20 /mi" "Pace best reported"
+assert_output_contains "$output" "New personal best! Longest Compliant Streak: 3 days" "Streak best reported"
+
+# Test 2: No best reported for a lesser session
+echo -e "\n${YELLOW}Test 2: No best reported for a lesser session${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 20 --distance 2 --date 2024-01-15 --completed 2>&1)
+assert_output_not_contains "$output" "New personal best" "Lesser session is not a best"
+
+# Test 3: Records table
+echo -e "\n${YELLOW}Test 3: Records table${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise records 2>&1)
+assert_output_contains "$output" "cycling   60 minutes" "Incomplete sessions are not bests"
+assert_output_contains "$output" "155 minutes  2024-01-08 to 2024-01-14" "Most minutes in a week"
+assert_output_contains "$output" "2024-01-08 to 2024-01-10" "Streak dates shown"
+
+# Test 4: Filter by activity
+echo -e "\n${YELLOW}Test 4: Filter by activity${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise records --activity bike 2>&1)
+assert_output_not_contains "$output" "jogging" "Other activities hidden"
+
+show_test_summary
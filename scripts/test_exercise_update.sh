@@ -25,7 +25,7 @@ reset_test_data
 # Sequential update tests (no reset between these)
 # Test 1: Update activity
 echo -e "\n${YELLOW}Test 1: Update activity${NC}"
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --activity cycling 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --activity cycling 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 verify_update
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
@@ -33,23 +33,24 @@ assert_output_contains "$record_check" "cycling" "Verifying updated activity"
 
 # Test 2: Update duration
 echo -e "\n${YELLOW}Test 2: Update duration${NC}"
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --duration 60 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --duration 60 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 verify_update
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
 assert_output_contains "$record_check" "60" "Verifying updated duration"
 
-# Test 3: Update to other activity
-echo -e "\n${YELLOW}Test 3: Update to other activity${NC}"
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --activity other --other-activity "swimming" 2>&1)
+# Test 3: Update to a user-defined activity
+echo -e "\n${YELLOW}Test 3: Update to a user-defined activity${NC}"
+TEST_MODE=true ./bin/tracker activity add swimming --category cardio
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --activity swimming 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 verify_update
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
-assert_output_contains "$record_check" "swimming" "Verifying other activity"
+assert_output_contains "$record_check" "swimming" "Verifying user-defined activity"
 
 # Test 4: Update completion status
 echo -e "\n${YELLOW}Test 4: Update completion status${NC}"
-output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --not-completed 2>&1)
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00001 --not-completed 2>&1)
 assert_output_contains "$output" "Exercise record updated successfully" "Update succeeded"
 verify_update
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
@@ -62,22 +63,22 @@ echo -e "\n${YELLOW}Error condition tests${NC}"
 
 # Test 5: Invalid activity
 echo -e "\n${YELLOW}Test 5: Invalid activity${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --activity invalid 2>&1)
+output=$(TEST_MODE=true ./bin/tracker exercise update e00001 --activity invalid 2>&1)
 assert_output_contains "$output" "invalid activity type" "Shows invalid activity message"
 
-# Test 6: Other activity without details
-echo -e "\n${YELLOW}Test 6: Invalid other activity${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --activity other 2>&1)
-assert_output_contains "$output" "other-activity flag is required" "Shows missing other activity message"
+# Test 6: The legacy other type is no longer accepted
+echo -e "\n${YELLOW}Test 6: Legacy other activity${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise update e00001 --activity other 2>&1)
+assert_output_contains "$output" "invalid activity type" "Shows invalid activity message"
 
 # Test 7: Update non-existent record
 echo -e "\n${YELLOW}Test 7: Update non-existent record${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise update --date 2023-01-01 --activity cycling 2>&1)
+output=$(TEST_MODE=true ./bin/tracker exercise update e99999 --activity cycling 2>&1)
 assert_output_contains "$output" "not found" "Shows not found message"
 
 # Test 8: Duration warning
 echo -e "\n${YELLOW}Test 8: Duration warning${NC}"
-output=$(echo "n" | TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --duration 120 2>&1)
+output=$(echo "n" | TEST_MODE=true ./bin/tracker exercise update e00001 --duration 120 2>&1)
 assert_output_contains "$output" "Duration change is substantial" "Shows substantial duration warning"
 assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
 record_check=$(TEST_MODE=true ./bin/tracker exercise get --date 2024-01-08 2>&1)
@@ -85,7 +86,7 @@ assert_output_contains "$record_check" "45" "Verifying no change after cancellat
 
 # Test 9: Conflicting completion flags
 echo -e "\n${YELLOW}Test 9: Conflicting completion flags${NC}"
-output=$(TEST_MODE=true ./bin/tracker exercise update --date 2024-01-08 --completed --not-completed 2>&1)
+output=$(TEST_MODE=true ./bin/tracker exercise update e00001 --completed --not-completed 2>&1)
 assert_output_contains "$output" "cannot use both" "Shows conflicting flags message"
 
 show_test_summary
\ No newline at end of file
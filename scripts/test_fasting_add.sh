@@ -0,0 +1,45 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_add"
+
+# Test 1: Basic add
+echo -e "\n${YELLOW}Test 1: Basic add${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting add --pattern full-fast --date 2024-01-08 --notes "Water only" 2>&1)
+assert_output_contains "$output" "Fasting record added successfully" "Record was added"
+assert_output_contains "$output" "Expected:   full-fast" "Expected pattern filled in"
+assert_output_contains "$output" "Compliant:  true" "Record is compliant"
+verify_fasting_file
+
+# Test 2: Non-compliant day
+echo -e "\n${YELLOW}Test 2: Non-compliant day${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting add --pattern regular --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Expected:   one-meal" "Expected pattern filled in"
+assert_output_contains "$output" "Compliant:  false" "Record is not compliant"
+
+# Test 3: Invalid pattern
+echo -e "\n${YELLOW}Test 3: Invalid pattern${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting add --pattern snacking --date 2024-01-11 2>&1)
+assert_output_contains "$output" "invalid meal pattern" "Invalid pattern rejected"
+
+# Test 4: Missing pattern
+echo -e "\n${YELLOW}Test 4: Missing pattern${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting add --date 2024-01-11 2>&1)
+assert_output_contains "$output" "required flag(s) \"pattern\" not set" "Missing pattern rejected"
+
+# Test 5: Duplicate date cancelled
+echo -e "\n${YELLOW}Test 5: Duplicate date cancelled${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Record already exists for" "Duplicate date detected"
+assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
+
+# Test 6: Duplicate date overwritten
+echo -e "\n${YELLOW}Test 6: Duplicate date overwritten${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Actual:     one-meal" "Record was overwritten"
+verify_fasting_file
+
+# Show results
+show_test_summary
@@ -0,0 +1,38 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_delete"
+
+# Helper function to reset test data
+reset_test_data() {
+    cleanup_test_data
+    echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern full-fast --date 2024-01-08 --notes "First fast"
+    verify_fasting_file
+}
+
+# Initial setup
+echo -e "\n${YELLOW}Setting up test data${NC}"
+reset_test_data
+
+# Test 1: Delete existing record
+echo -e "\n${YELLOW}Test 1: Delete existing record${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker fasting delete --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Fasting record deleted successfully" "Delete succeeded"
+verify_fasting_file
+
+# Reset data before next test
+reset_test_data
+
+# Test 2: Delete cancelled
+echo -e "\n${YELLOW}Test 2: Delete cancelled${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker fasting delete --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
+
+# Test 3: Delete non-existent record
+echo -e "\n${YELLOW}Test 3: Delete non-existent record${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting delete --date 2023-01-01 2>&1)
+assert_output_contains "$output" "not found" "Shows not found message"
+
+show_test_summary
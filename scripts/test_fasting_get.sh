@@ -0,0 +1,29 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_get"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-10 --notes "Dinner only"
+verify_fasting_file
+
+# Test 1: Get existing record
+echo -e "\n${YELLOW}Test 1: Get existing record${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Actual:     one-meal" "Shows actual pattern"
+assert_output_contains "$output" "Dinner only" "Shows notes"
+
+# Test 2: Get non-existent record
+echo -e "\n${YELLOW}Test 2: Get non-existent record${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-11 2>&1)
+assert_output_contains "$output" "not found" "Shows not found message"
+
+# Test 3: Invalid date format
+echo -e "\n${YELLOW}Test 3: Invalid date format${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date "invalid" 2>&1)
+assert_output_contains "$output" "invalid date format" "Shows invalid date message"
+
+show_test_summary
@@ -0,0 +1,32 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_list"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern full-fast --date 2024-01-08 --notes "Monday fast"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-09 --notes "Slipped"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern regular --date 2024-01-13 --notes "Weekend"
+verify_fasting_file
+
+# Test 1: Date range
+echo -e "\n${YELLOW}Test 1: Date range${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting list --from 2024-01-08 --to 2024-01-14 2>&1)
+assert_output_contains "$output" "Monday fast" "Shows records within range"
+assert_output_contains "$output" "Total Records  : 3" "Shows all records"
+assert_output_contains "$output" "Compliant Days : 2" "Counts compliant days"
+
+# Test 2: Month flag
+echo -e "\n${YELLOW}Test 2: Month flag${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting list --month 2>&1)
+assert_output_contains "$output" "Fasting Records from 2024-01-01 to 2024-01-31" "Shows correct month range"
+
+# Test 3: Empty range
+echo -e "\n${YELLOW}Test 3: Empty range${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting list --from 2023-01-01 --to 2023-01-31 2>&1)
+assert_output_contains "$output" "No fasting records found" "Shows empty range message"
+
+show_test_summary
@@ -0,0 +1,44 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_schedule"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern full-fast --date 2024-01-08 --notes "Before change"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-02-05 --notes "After change"
+verify_fasting_file
+
+# Test 1: Default schedule
+echo -e "\n${YELLOW}Test 1: Default schedule${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting schedule show 2>&1)
+assert_output_contains "$output" "Monday:     full-fast" "Shows default Monday pattern"
+assert_output_contains "$output" "Wednesday:  one-meal" "Shows default Wednesday pattern"
+
+# Test 2: Apply preset from a date
+echo -e "\n${YELLOW}Test 2: Apply preset from a date${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker fasting schedule set --preset omad --from 2024-02-01 2>&1)
+assert_output_contains "$output" "Fasting schedule updated successfully" "Schedule saved"
+assert_output_contains "$output" "Updated expected pattern on 1 existing record(s)" "Later records re-evaluated"
+
+# Test 3: History stays correct
+echo -e "\n${YELLOW}Test 3: History stays correct${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Expected:   full-fast" "Earlier record keeps old expectation"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-02-05 2>&1)
+assert_output_contains "$output" "Expected:   one-meal" "Later record uses new expectation"
+assert_output_contains "$output" "Compliant:  true" "Compliance uses new expectation"
+
+# Test 4: New records use the schedule
+echo -e "\n${YELLOW}Test 4: New records use the schedule${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-02-06 2>&1)
+assert_output_contains "$output" "Expected:   one-meal" "Expected pattern filled from schedule"
+
+# Test 5: Invalid preset
+echo -e "\n${YELLOW}Test 5: Invalid preset${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting schedule set --preset keto 2>&1)
+assert_output_contains "$output" "unknown schedule preset" "Invalid preset rejected"
+
+show_test_summary
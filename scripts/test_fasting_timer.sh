@@ -0,0 +1,55 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_timer"
+
+# Test 1: Start a fast
+echo -e "\n${YELLOW}Test 1: Start a fast${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting start --at "2024-01-07 20:00" --notes "36 hour fast" 2>&1)
+assert_output_contains "$output" "Fast started" "Fast was started"
+
+# Test 2: Status shows fast in progress
+echo -e "\n${YELLOW}Test 2: Status${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting status 2>&1)
+assert_output_contains "$output" "Fast In Progress" "Shows fast in progress"
+assert_output_contains "$output" "Elapsed" "Shows elapsed hours"
+
+# Test 3: Only one fast at a time
+echo -e "\n${YELLOW}Test 3: Only one fast at a time${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting start 2>&1)
+assert_output_contains "$output" "already in progress" "Second fast rejected"
+
+# Test 4: Stop the fast
+echo -e "\n${YELLOW}Test 4: Stop the fast${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting stop --at "2024-01-09 08:00" 2>&1)
+assert_output_contains "$output" "Fast completed after 36.0 hours" "Duration computed"
+assert_output_contains "$output" "Derived meal pattern for 3 day(s)" "Daily patterns derived"
+
+# Test 5: Derived daily pattern
+echo -e "\n${YELLOW}Test 5: Derived daily pattern${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Actual:     full-fast" "Whole day fasted is a full fast"
+assert_output_contains "$output" "Source:     timer" "Pattern came from the timer"
+
+# Test 6: Manual records are not overwritten
+echo -e "\n${YELLOW}Test 6: Manual records are not overwritten${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-10
+TEST_MODE=true ./bin/tracker fasting start --at "2024-01-10 00:00"
+TEST_MODE=true ./bin/tracker fasting stop --at "2024-01-10 23:59"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Actual:     one-meal" "Manual pattern kept"
+
+# Test 7: List fasts
+echo -e "\n${YELLOW}Test 7: List fasts${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting windows --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "Completed Fasts : 2" "Lists completed fasts"
+assert_output_contains "$output" "Multi-Day Fasts : 1" "Counts multi-day fasts"
+
+# Test 8: Stop without a fast
+echo -e "\n${YELLOW}Test 8: Stop without a fast${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting stop 2>&1)
+assert_output_contains "$output" "no fast in progress" "Shows no fast message"
+
+show_test_summary
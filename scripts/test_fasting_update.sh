@@ -0,0 +1,39 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "fasting_update"
+
+# Helper function to reset test data
+reset_test_data() {
+    cleanup_test_data
+    echo "y" | TEST_MODE=true ./bin/tracker fasting add --pattern one-meal --date 2024-01-08 --notes "First fast"
+    verify_fasting_file
+}
+
+# Initial setup
+echo -e "\n${YELLOW}Setting up test data${NC}"
+reset_test_data
+
+# Test 1: Update pattern
+echo -e "\n${YELLOW}Test 1: Update pattern${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker fasting update --date 2024-01-08 --pattern full-fast 2>&1)
+assert_output_contains "$output" "Fasting record updated successfully" "Update succeeded"
+record_check=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-08 2>&1)
+assert_output_contains "$record_check" "Compliant:  true" "Verifying updated compliance"
+
+# Reset data before next test
+reset_test_data
+
+# Test 2: Update cancelled
+echo -e "\n${YELLOW}Test 2: Update cancelled${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker fasting update --date 2024-01-08 --pattern regular 2>&1)
+assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
+
+# Test 3: Update non-existent record
+echo -e "\n${YELLOW}Test 3: Update non-existent record${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting update --date 2023-01-01 --pattern regular 2>&1)
+assert_output_contains "$output" "not found" "Shows not found message"
+
+show_test_summary
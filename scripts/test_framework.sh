@@ -57,6 +57,23 @@ assert_output_contains() {
     fi  # Fixed the syntax error here
 }
 
+assert_output_not_contains() {
+    local output=$1
+    local unexpected=$2
+    local message=$3
+    ((TOTAL++))
+
+    if [[ "$output" != *"$unexpected"* ]]; then
+        echo -e "${GREEN}✓ $message${NC}"
+        ((PASSED++))
+    else
+        echo -e "${RED}✗ $message${NC}"
+        echo "Did not expect: $unexpected"
+        echo "Got: $output"
+        ((FAILED++))
+    fi
+}
+
 # Data verification
 verify_data_file() {
     if [ -f "$TEST_DATA_DIR/weight.json" ]; then
@@ -78,6 +95,26 @@ verify_exercise_file() {
     fi
 }
 
+verify_fasting_file() {
+    if [ -f "$TEST_DATA_DIR/fasting.json" ]; then
+        echo -e "${YELLOW}Current test data:${NC}"
+        cat "$TEST_DATA_DIR/fasting.json"
+    else
+        echo -e "${RED}No test data file found!${NC}"
+        return 1
+    fi
+}
+
+verify_soda_file() {
+    if [ -f "$TEST_DATA_DIR/soda.json" ]; then
+        echo -e "${YELLOW}Current test data:${NC}"
+        cat "$TEST_DATA_DIR/soda.json"
+    else
+        echo -e "${RED}No test data file found!${NC}"
+        return 1
+    fi
+}
+
 # Summary
 show_test_summary() {
     echo -e "\n${YELLOW}Test Summary${NC}"
@@ -0,0 +1,78 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "gear"
+
+# Test 1: The first gear for an activity becomes its default
+echo -e "\n${YELLOW}Test 1: Add gear${NC}"
+output=$(TEST_MODE=true ./bin/tracker gear add "Pegasus 40" --activity run --retire-at 10mi 2>&1)
+assert_output_contains "$output" "Gear added successfully" "Gear added"
+assert_output_contains "$output" "Default gear for jogging sessions" "First gear is the default"
+output=$(TEST_MODE=true ./bin/tracker gear add "Trail Shoes" --activity jogging --initial 5mi --retire-at 100h 2>&1)
+assert_output_not_contains "$output" "Default gear" "Second gear is not the default"
+assert_output_contains "$output" "Usage:      5.0 mi" "Initial usage counted"
+output=$(TEST_MODE=true ./bin/tracker gear add "pegasus 40" --activity jogging 2>&1)
+assert_output_contains "$output" "already exists" "Duplicate names rejected"
+output=$(TEST_MODE=true ./bin/tracker gear add "Skis" --activity skiing --retire-at 400 2>&1)
+assert_output_contains "$output" "needs a unit" "Threshold without a unit rejected"
+
+# Test 2: Sessions link to the default gear and usage accumulates
+echo -e "\n${YELLOW}Test 2: Sessions accumulate usage${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 45 --distance 5 --date 2024-01-08 --completed 2>&1)
+assert_output_contains "$output" "Pegasus 40: 5.0 mi, 0.8 h over 1 session(s)" "Default gear linked"
+assert_output_not_contains "$output" "retirement threshold" "No warning under the threshold"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --distance 3 --date 2024-01-09 --gear "trail shoes" --completed 2>&1)
+assert_output_contains "$output" "Trail Shoes: 8.0 mi" "Gear chosen by name"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 30 --date 2024-01-09 --gear none --completed 2>&1)
+assert_output_not_contains "$output" "session(s) (retire at" "No gear linked with none"
+
+# Test 3: Passing the threshold warns
+echo -e "\n${YELLOW}Test 3: Retirement warning${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 50 --distance 6 --date 2024-01-10 --completed 2>&1)
+assert_output_contains "$output" "Pegasus 40 has passed its retirement threshold of 10 mi" "Warning when past the threshold"
+output=$(TEST_MODE=true ./bin/tracker gear list 2>&1)
+assert_output_contains "$output" "past threshold" "List shows worn out gear"
+
+# Test 4: Updating a session moves its usage
+echo -e "\n${YELLOW}Test 4: Update links${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker exercise update e00004 --gear "Trail Shoes" 2>&1)
+assert_output_contains "$output" "Trail Shoes: 14.0 mi" "Session moved to other gear"
+output=$(TEST_MODE=true ./bin/tracker gear list 2>&1)
+assert_output_not_contains "$output" "past threshold" "Usage moved off the old gear"
+
+# Test 5: Retired gear and defaults
+echo -e "\n${YELLOW}Test 5: Retire and change defaults${NC}"
+output=$(TEST_MODE=true ./bin/tracker gear retire "Pegasus 40" 2>&1)
+assert_output_contains "$output" "Gear retired" "Gear retired"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 20 --date 2024-01-11 --gear g00001 2>&1)
+assert_output_contains "$output" "is retired" "Retired gear can't be linked"
+output=$(TEST_MODE=true ./bin/tracker gear default "Trail Shoes" 2>&1)
+assert_output_contains "$output" "Default gear for jogging sessions" "Default changed"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 20 --date 2024-01-11 2>&1)
+assert_output_contains "$output" "Trail Shoes:" "New default linked"
+output=$(TEST_MODE=true ./bin/tracker gear list 2>&1)
+assert_output_not_contains "$output" "Pegasus 40" "Retired gear hidden"
+output=$(TEST_MODE=true ./bin/tracker gear list --all 2>&1)
+assert_output_contains "$output" "retired" "Retired gear listed with --all"
+
+# Test 6: Names shared with retired gear
+echo -e "\n${YELLOW}Test 6: Reused names${NC}"
+output=$(TEST_MODE=true ./bin/tracker gear add "Pegasus 40" --activity jogging --default 2>&1)
+assert_output_contains "$output" "Default gear for jogging sessions" "Replacement gear added as the default"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 20 --date 2024-01-12 --gear "pegasus 40" --completed 2>&1)
+assert_output_contains "$output" "Pegasus 40: 0.0 mi" "Name finds the active gear"
+TEST_MODE=true ./bin/tracker gear retire g00003 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker gear retire "Pegasus 40" --restore 2>&1)
+assert_output_contains "$output" "several gear named Pegasus 40: use an ID (g00001, g00003)" "Ambiguous name reported"
+output=$(TEST_MODE=true ./bin/tracker gear default "Trail Shoes" 2>&1)
+assert_output_contains "$output" "Default gear for jogging sessions" "Default restored to the remaining gear"
+
+# Test 7: Gear names are shown as given in warnings
+echo -e "\n${YELLOW}Test 7: Names with a percent sign${NC}"
+TEST_MODE=true ./bin/tracker gear add "Shoe 100%" --activity walking --retire-at 1mi > /dev/null 2>&1
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 30 --distance 2 --date 2024-01-13 --completed 2>&1)
+assert_output_contains "$output" "Shoe 100% has passed its retirement threshold" "Warning shows the name unchanged"
+
+show_test_summary
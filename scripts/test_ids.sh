@@ -0,0 +1,36 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "ids"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+for day in 01 02 03; do
+    TEST_MODE=true ./bin/tracker weight add --value 190 --date 2024-01-$day > /dev/null
+    TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 30 --date 2024-01-$day --completed > /dev/null
+done
+
+# Test 1: IDs freed by a deletion aren't handed out again
+echo -e "\n${YELLOW}Test 1: No reuse after delete${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker weight delete w00002 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker weight add --value 189 --date 2024-01-04 2>&1)
+assert_output_contains "$output" "ID:     w00004" "Next weight ID follows the highest ever used"
+echo "y" | TEST_MODE=true ./bin/tracker exercise delete e00003 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity walking --duration 30 --date 2024-01-04 --completed 2>&1)
+assert_output_contains "$output" "e00004" "Next exercise ID follows the highest ever used"
+output=$(cat "$TEST_DATA_DIR/ids.json")
+assert_output_contains "$output" "\"weight\": 4" "Counter persisted"
+
+# Test 2: Duplicate IDs left by earlier versions are repaired on start-up
+echo -e "\n${YELLOW}Test 2: Repair duplicates${NC}"
+sed -i 's/"w00004"/"w00003"/' "$TEST_DATA_DIR/weight.json"
+rm "$TEST_DATA_DIR/ids.json"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-05 2>&1)
+assert_output_contains "$output" "w00003    2024-01-03" "First record keeps its ID"
+assert_output_contains "$output" "w00004    2024-01-04" "Duplicate given a new ID"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 188 --date 2024-01-05 2>&1)
+assert_output_contains "$output" "ID:     w00005" "Counter continues after the repair"
+
+show_test_summary
@@ -0,0 +1,47 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "lift"
+
+# Test 1: Add a strength session
+echo -e "\n${YELLOW}Test 1: Add a strength session${NC}"
+output=$(TEST_MODE=true ./bin/tracker lift add --duration 30 --date 2024-01-08 --lift squat=3x5@225 --lift "Bench Press=5@185,5@185,4@185" --lift pullup=3x8 2>&1)
+assert_output_contains "$output" "Lift session added successfully" "Session added"
+assert_output_contains "$output" "Total volume: 5965 lbs" "Computes total volume"
+assert_output_contains "$output" "bench_press  2x5@185, 1x4@185" "Groups repeated sets"
+assert_output_contains "$output" "262.5" "Estimates 1RM with Epley"
+
+# Test 2: Sessions count toward the daily goal
+echo -e "\n${YELLOW}Test 2: Sessions count toward the daily goal${NC}"
+output=$(TEST_MODE=true ./bin/tracker exercise add --activity jogging --duration 20 --date 2024-01-08 --completed 2>&1)
+assert_output_contains "$output" "50 minutes across 2 session(s), goal met" "Lifting and cardio combined"
+
+# Test 3: Progression history
+echo -e "\n${YELLOW}Test 3: Progression history${NC}"
+TEST_MODE=true ./bin/tracker lift add --duration 45 --date 2024-01-10 --lift squat=3x5@235,1x3@255
+output=$(TEST_MODE=true ./bin/tracker lift history squat 2>&1)
+assert_output_contains "$output" "+18.0" "Shows change in estimated 1RM"
+assert_output_contains "$output" "Best Est. 1RM: 280.5 lbs on 2024-01-10" "Shows best estimate"
+output=$(TEST_MODE=true ./bin/tracker lift history squat --formula brzycki 2>&1)
+assert_output_contains "$output" "270.0" "Estimates 1RM with Brzycki"
+
+# Test 4: Invalid input
+echo -e "\n${YELLOW}Test 4: Invalid input${NC}"
+output=$(TEST_MODE=true ./bin/tracker lift add --duration 30 --lift squat 2>&1)
+assert_output_contains "$output" "invalid lift" "Missing sets rejected"
+output=$(TEST_MODE=true ./bin/tracker lift add --duration 30 --lift squat=3x0@225 2>&1)
+assert_output_contains "$output" "reps must be between 1 and 100" "Invalid reps rejected"
+output=$(TEST_MODE=true ./bin/tracker lift add --duration 30 --activity jogging --lift squat=5@225 2>&1)
+assert_output_contains "$output" "need a strength activity" "Cardio activity rejected"
+output=$(TEST_MODE=true ./bin/tracker lift history deadlift 2>&1)
+assert_output_contains "$output" "Lift history not found: deadlift" "Unknown lift reported"
+
+# Test 5: Without a date the session is stored for today
+echo -e "\n${YELLOW}Test 5: Default date${NC}"
+TEST_MODE=true ./bin/tracker lift add --duration 30 --lift squat=3x5@225 > /dev/null 2>&1
+output=$(cat "$TEST_DATA_DIR/exercise.json")
+assert_output_contains "$output" "\"date\": \"$(date +%Y-%m-%d)T00:00:00Z\"" "Stored as today's date"
+
+show_test_summary
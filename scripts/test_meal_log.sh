@@ -0,0 +1,62 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "meal_log"
+
+# Test 1: Log a single meal
+echo -e "\n${YELLOW}Test 1: Log a single meal${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal log --date 2024-01-08 --at 18:30 --notes "Salmon" 2>&1)
+assert_output_contains "$output" "Meal logged successfully" "Meal was logged"
+assert_output_contains "$output" "derived as one-meal" "One meal derives one-meal"
+
+# Test 2: Second meal makes the day regular
+echo -e "\n${YELLOW}Test 2: Second meal${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal log --date 2024-01-08 --at 12:00 2>&1)
+assert_output_contains "$output" "derived as regular (eating window 6.5 hours)" "Eating window computed"
+
+# Test 3: Derived record feeds fasting
+echo -e "\n${YELLOW}Test 3: Derived fasting record${NC}"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Source:     meals" "Pattern came from meals"
+assert_output_contains "$output" "Eating:     6.5 hours" "Eating window shown"
+
+# Test 4: Duplicate meal time rejected
+echo -e "\n${YELLOW}Test 4: Duplicate meal time${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal log --date 2024-01-08 --at 12:00 2>&1)
+assert_output_contains "$output" "already logged" "Duplicate meal rejected"
+
+# Test 5: Override the derived pattern
+echo -e "\n${YELLOW}Test 5: Override${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal override --date 2024-01-08 --pattern one-meal 2>&1)
+assert_output_contains "$output" "Override set" "Override applied"
+output=$(TEST_MODE=true ./bin/tracker meal log --date 2024-01-08 --at 20:00 2>&1)
+assert_output_contains "$output" "overridden as one-meal" "Override kept when logging"
+
+# Test 6: List shows override
+echo -e "\n${YELLOW}Test 6: List meals${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "one-meal (override)" "Override shown in list"
+assert_output_contains "$output" "Total Meals" "Shows statistics"
+
+# Test 7: Clear the override
+echo -e "\n${YELLOW}Test 7: Clear override${NC}"
+output=$(TEST_MODE=true ./bin/tracker meal override --date 2024-01-08 --clear 2>&1)
+assert_output_contains "$output" "derived as regular (eating window 8.0 hours)" "Pattern re-derived"
+
+# Test 8: Delete meals re-derives
+echo -e "\n${YELLOW}Test 8: Delete meal${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker meal delete --date 2024-01-08 --at 20:00
+output=$(echo "y" | TEST_MODE=true ./bin/tracker meal delete --date 2024-01-08 --at 12:00 2>&1)
+assert_output_contains "$output" "derived as one-meal" "Pattern re-derived after delete"
+
+# Test 9: Deleting the last meal removes the derived pattern
+echo -e "\n${YELLOW}Test 9: Delete last meal${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker meal delete --date 2024-01-08 --at 18:30 2>&1)
+assert_output_contains "$output" "no meals left to derive it from" "Derived pattern removed"
+assert_output_not_contains "$output" "full-fast" "Day not re-derived as a full fast"
+output=$(TEST_MODE=true ./bin/tracker fasting get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "not found" "No fasting record left for the day"
+
+show_test_summary
@@ -0,0 +1,60 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_add"
+
+# Test 1: Basic add
+echo -e "\n${YELLOW}Test 1: Basic add${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-13 --notes "Movie night" 2>&1)
+assert_output_contains "$output" "Soda record added successfully" "Record was added"
+assert_output_contains "$output" "12.0 oz" "Shows quantity"
+assert_output_contains "$output" "Compliant:  true" "Weekend allowance is compliant"
+verify_soda_file
+
+# Test 2: Soda-free day
+echo -e "\n${YELLOW}Test 2: Soda-free day${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda add --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Consumed:   false" "Soda-free day recorded"
+
+# Test 3: Weekday consumption
+echo -e "\n${YELLOW}Test 3: Weekday consumption${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-09 2>&1)
+assert_output_contains "$output" "Compliant:  false" "Weekday soda is not compliant"
+
+# Test 4: Consumed without quantity
+echo -e "\n${YELLOW}Test 4: Consumed without quantity${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda add --consumed --date 2024-01-10 2>&1)
+assert_output_contains "$output" "quantity must be greater than 0" "Missing quantity rejected"
+
+# Test 5: Quantity without consumed
+echo -e "\n${YELLOW}Test 5: Quantity without consumed${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda add --quantity 12 --date 2024-01-10 2>&1)
+assert_output_contains "$output" "quantity should be 0" "Quantity without consumed rejected"
+
+# Test 6: Duplicate date cancelled
+echo -e "\n${YELLOW}Test 6: Duplicate date cancelled${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Record already exists for" "Duplicate date detected"
+assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
+
+# Test 7: Duplicate date overwritten
+echo -e "\n${YELLOW}Test 7: Duplicate date overwritten${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-13 2>&1)
+assert_output_contains "$output" "8.0 oz" "Record was overwritten"
+verify_soda_file
+
+# Test 8: Adding twice without a date keeps one record for today
+echo -e "\n${YELLOW}Test 8: Default date${NC}"
+today=$(date +%Y-%m-%d)
+TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 > /dev/null 2>&1
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 2>&1)
+assert_output_contains "$output" "Record already exists for $today" "Second add on the same day detected"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda update --date $today --quantity 4 2>&1)
+assert_output_contains "$output" "4.0 oz" "Today's record updated"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda delete --date $today 2>&1)
+assert_output_not_contains "$output" "not found" "Today's record deleted"
+
+# Show results
+show_test_summary
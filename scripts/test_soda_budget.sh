@@ -0,0 +1,56 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_budget"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+TEST_MODE=true ./bin/tracker soda allowance set --weekend-cap 16 --budget 24 --rollover
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 16 --date 2024-01-06
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-12
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 16 --date 2024-01-13
+verify_soda_file
+
+# Test 1: Daily cap from allowance
+echo -e "\n${YELLOW}Test 1: Daily cap from allowance${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Daily Cap:  16.0 oz" "Record shows configured cap"
+assert_output_contains "$output" "Compliant:  true" "16 oz within configured cap"
+
+# Test 2: Budget with rollover
+echo -e "\n${YELLOW}Test 2: Budget with rollover${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda budget --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Used       : 28.0 oz" "Shows used ounces"
+assert_output_contains "$output" "Rolled Over: 8.0 oz" "Carries unused budget forward"
+assert_output_contains "$output" "Remaining  : 4.0 oz" "Shows remaining ounces"
+
+# Test 3: Over weekly budget
+echo -e "\n${YELLOW}Test 3: Over weekly budget${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-14
+output=$(TEST_MODE=true ./bin/tracker soda budget --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Weekly budget exceeded by 4.0 oz" "Warns when over budget"
+output=$(TEST_MODE=true ./bin/tracker soda list --from 2024-01-08 --to 2024-01-14 2>&1)
+assert_output_contains "$output" "Compliant Days : 2" "Over-budget day is not compliant"
+
+# Test 4: Rollover into a week with nothing logged yet
+echo -e "\n${YELLOW}Test 4: Rollover into an empty week${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 4 --date 2024-01-15
+output=$(TEST_MODE=true ./bin/tracker soda budget --date 2024-01-24 2>&1)
+assert_output_contains "$output" "Rolled Over: 20.0 oz" "Empty week still receives rollover"
+assert_output_contains "$output" "Remaining  : 44.0 oz" "Rollover added to the remaining budget"
+
+# Test 5: Compliance follows the current allowance
+echo -e "\n${YELLOW}Test 5: Compliance re-judged after allowance change${NC}"
+TEST_MODE=true ./bin/tracker soda allowance set --weekend-cap 8 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Daily Cap:  8.0 oz" "Record shows the new cap"
+assert_output_contains "$output" "Compliant:  false" "16 oz now over the cap"
+
+# Test 6: Invalid allowance
+echo -e "\n${YELLOW}Test 6: Invalid allowance${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda allowance set --budget 0 --rollover 2>&1)
+assert_output_contains "$output" "rollover requires a weekly budget" "Invalid rules rejected"
+
+show_test_summary
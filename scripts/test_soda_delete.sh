@@ -0,0 +1,37 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_delete"
+
+# Helper function to reset test data
+reset_test_data() {
+    cleanup_test_data
+    echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-13
+    verify_soda_file
+}
+
+# Initial setup
+echo -e "\n${YELLOW}Setting up test data${NC}"
+reset_test_data
+
+# Test 1: Delete existing record
+echo -e "\n${YELLOW}Test 1: Delete existing record${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda delete --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Soda record deleted successfully" "Delete succeeded"
+
+# Reset data before next test
+reset_test_data
+
+# Test 2: Delete cancelled
+echo -e "\n${YELLOW}Test 2: Delete cancelled${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker soda delete --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Operation cancelled" "Shows cancellation message"
+
+# Test 3: Delete non-existent record
+echo -e "\n${YELLOW}Test 3: Delete non-existent record${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda delete --date 2023-01-01 2>&1)
+assert_output_contains "$output" "not found" "Shows not found message"
+
+show_test_summary
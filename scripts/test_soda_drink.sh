@@ -0,0 +1,67 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_drink"
+
+# Test 1: Add beverages to the catalog
+echo -e "\n${YELLOW}Test 1: Beverage catalog${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda beverage add "Coke" --caffeine 2.8 --sugar 3.25 2>&1)
+assert_output_contains "$output" "Beverage added successfully" "Beverage added"
+TEST_MODE=true ./bin/tracker soda beverage add "Diet Coke" --caffeine 3.8 --sugar 0
+output=$(TEST_MODE=true ./bin/tracker soda beverage list 2>&1)
+assert_output_contains "$output" "Diet Coke" "Catalog lists beverages"
+
+# Test 2: Unknown beverage rejected
+echo -e "\n${YELLOW}Test 2: Unknown beverage${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda drink add --beverage Pepsi --size 12 --date 2024-01-12 --at 12:00 2>&1)
+assert_output_contains "$output" "unknown beverage" "Unknown beverage rejected"
+
+# Test 3: Log drinks and derive the day
+echo -e "\n${YELLOW}Test 3: Log drinks${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda drink add --beverage coke --size 12 --date 2024-01-12 --at 12:00 2>&1)
+assert_output_contains "$output" "Drink logged successfully" "Drink logged"
+output=$(TEST_MODE=true ./bin/tracker soda drink add --beverage "Diet Coke" --size 8 --date 2024-01-12 --at 18:00 2>&1)
+assert_output_contains "$output" "total: 20.0 oz, 64 mg caffeine, 39.0 g sugar" "Daily totals derived"
+
+# Test 4: Daily record reflects the drinks
+echo -e "\n${YELLOW}Test 4: Derived soda record${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-12 2>&1)
+assert_output_contains "$output" "Quantity:   20.0 oz" "Quantity summed"
+assert_output_contains "$output" "Compliant:  false" "Over the 12 oz cap"
+
+# Test 5: Nutrition report
+echo -e "\n${YELLOW}Test 5: Nutrition report${NC}"
+TEST_MODE=true ./bin/tracker soda drink add --beverage coke --size 12 --date 2024-01-13 --at 15:00
+output=$(TEST_MODE=true ./bin/tracker soda list --from 2024-01-08 --to 2024-01-14 --nutrition 2>&1)
+assert_output_contains "$output" "Weekly Caffeine and Sugar" "Shows weekly totals"
+assert_output_contains "$output" "Total Caffeine" "Shows caffeine total"
+
+# Test 6: Delete a drink re-derives the day
+echo -e "\n${YELLOW}Test 6: Delete drink${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda drink delete --date 2024-01-12 --at 18:00 2>&1)
+assert_output_contains "$output" "2024-01-12 total: 12.0 oz" "Day re-derived"
+
+# Test 7: A day entered by hand keeps its record
+echo -e "\n${YELLOW}Test 7: Hand-entered day${NC}"
+TEST_MODE=true ./bin/tracker soda add --consumed --quantity 6 --date 2024-01-10 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker soda drink add --beverage coke --size 12 --date 2024-01-10 --at 12:00 2>&1)
+assert_output_contains "$output" "2024-01-10 was entered by hand as 6.0 oz" "Drink doesn't overwrite the hand-entered record"
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-10 2>&1)
+assert_output_contains "$output" "Quantity:   6.0 oz" "Hand-entered quantity kept"
+
+# Test 8: A drink that makes the day invalid is rejected
+echo -e "\n${YELLOW}Test 8: Invalid day total${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda drink add --beverage coke --size 60 --date 2024-01-13 --at 18:00 2>&1)
+assert_output_contains "$output" "unreasonably high" "Derived record validated"
+output=$(TEST_MODE=true ./bin/tracker soda drink list --from 2024-01-13 --to 2024-01-13 2>&1)
+assert_output_not_contains "$output" "18:00" "Rejected drink not kept"
+
+# Test 9: Deleting the last drink removes the derived record
+echo -e "\n${YELLOW}Test 9: Delete last drink${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker soda drink delete --date 2024-01-12 --at 12:00 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-12 2>&1)
+assert_output_contains "$output" "not found" "Derived record removed"
+
+show_test_summary
@@ -0,0 +1,29 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_get"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-13 --notes "Movie night"
+verify_soda_file
+
+# Test 1: Get existing record
+echo -e "\n${YELLOW}Test 1: Get existing record${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-13 2>&1)
+assert_output_contains "$output" "12.0 oz" "Shows quantity"
+assert_output_contains "$output" "Movie night" "Shows notes"
+
+# Test 2: Get non-existent record
+echo -e "\n${YELLOW}Test 2: Get non-existent record${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda get --date 2024-01-14 2>&1)
+assert_output_contains "$output" "not found" "Shows not found message"
+
+# Test 3: Invalid date format
+echo -e "\n${YELLOW}Test 3: Invalid date format${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda get --date "invalid" 2>&1)
+assert_output_contains "$output" "invalid date format" "Shows invalid date message"
+
+show_test_summary
@@ -0,0 +1,29 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_list"
+
+# Setup test data
+echo -e "\n${YELLOW}Setting up test data${NC}"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --date 2024-01-08 --notes "Clean day"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-09 --notes "Lunch slip"
+echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-13 --notes "Movie night"
+verify_soda_file
+
+# Test 1: Date range
+echo -e "\n${YELLOW}Test 1: Date range${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda list --from 2024-01-08 --to 2024-01-14 2>&1)
+assert_output_contains "$output" "Movie night" "Shows records within range"
+assert_output_contains "$output" "Total Ounces   : 20.0 oz" "Shows total ounces"
+assert_output_contains "$output" "Weekday Ounces : 8.0 oz" "Shows weekday ounces"
+assert_output_contains "$output" "Weekend Ounces : 12.0 oz" "Shows weekend ounces"
+assert_output_contains "$output" "Compliant Days : 2" "Counts compliant days"
+
+# Test 2: Empty range
+echo -e "\n${YELLOW}Test 2: Empty range${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda list --from 2023-01-01 --to 2023-01-31 2>&1)
+assert_output_contains "$output" "No soda records found" "Shows empty range message"
+
+show_test_summary
@@ -0,0 +1,55 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "soda_update"
+
+# Helper function to reset test data
+reset_test_data() {
+    cleanup_test_data
+    echo "y" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 12 --date 2024-01-13 --notes "Movie night"
+    verify_soda_file
+}
+
+# Initial setup
+echo -e "\n${YELLOW}Setting up test data${NC}"
+reset_test_data
+
+# Test 1: Update quantity
+echo -e "\n${YELLOW}Test 1: Update quantity${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda update --date 2024-01-13 --quantity 20 2>&1)
+assert_output_contains "$output" "Soda record updated successfully" "Update succeeded"
+assert_output_contains "$output" "Compliant:  false" "Over the weekend allowance"
+
+# Reset data before next test
+reset_test_data
+
+# Test 2: Mark soda-free
+echo -e "\n${YELLOW}Test 2: Mark soda-free${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda update --date 2024-01-13 --not-consumed 2>&1)
+assert_output_contains "$output" "Consumed:   false" "Marked soda-free"
+
+# Test 3: Quantity alone marks the day consumed again
+echo -e "\n${YELLOW}Test 3: Update quantity alone${NC}"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda update --date 2024-01-13 --quantity 8 2>&1)
+assert_output_contains "$output" "Consumed:   true" "Quantity alone marks soda consumed"
+assert_output_contains "$output" "Quantity:   8.0 oz" "Quantity updated"
+
+# Test 4: Invalid update
+echo -e "\n${YELLOW}Test 4: Invalid update${NC}"
+output=$(TEST_MODE=true ./bin/tracker soda update --date 2024-01-13 --consumed --quantity 100 2>&1)
+assert_output_contains "$output" "unreasonably high" "Validation enforced"
+
+# Test 5: A record stored with a time of day is still matched by its date
+echo -e "\n${YELLOW}Test 5: Record with a time of day${NC}"
+reset_test_data
+sed -i 's/2024-01-13T00:00:00Z/2024-01-13T15:30:00Z/' "$TEST_DATA_DIR/soda.json"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker soda add --consumed --quantity 8 --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Record already exists for 2024-01-13" "Same-day duplicate detected"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda update --date 2024-01-13 --quantity 4 2>&1)
+assert_output_contains "$output" "Soda record updated successfully" "Record updated by date"
+output=$(echo "y" | TEST_MODE=true ./bin/tracker soda delete --date 2024-01-13 2>&1)
+assert_output_contains "$output" "Soda record deleted successfully" "Record deleted by date"
+
+show_test_summary
@@ -0,0 +1,41 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "weight_composition"
+
+# Test 1: Readings are stored with derived fat and lean mass
+echo -e "\n${YELLOW}Test 1: Add body composition${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 200 --date 2024-01-01 --body-fat 25 --muscle 140 --water 52 --visceral-fat 10 2>&1)
+assert_output_contains "$output" "Body Fat:     25.0%" "Body fat shown"
+assert_output_contains "$output" "Fat Mass:     50.0 lbs" "Fat mass derived"
+assert_output_contains "$output" "Lean Mass:    150.0 lbs" "Lean mass derived"
+assert_output_contains "$output" "Visceral Fat: 10" "Visceral fat shown"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 198 --date 2024-01-03 2>&1)
+assert_output_not_contains "$output" "Body Composition" "Readings are optional"
+
+# Test 2: Readings are validated
+echo -e "\n${YELLOW}Test 2: Validation${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 194 --date 2024-01-09 --body-fat 90 2>&1)
+assert_output_contains "$output" "body fat must be between 2% and 75%" "Body fat out of range rejected"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 194 --date 2024-01-09 --body-fat 30 --muscle 150 2>&1)
+assert_output_contains "$output" "muscle mass must not exceed lean mass" "Muscle above lean mass rejected"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 194 --date 2024-01-09 --visceral-fat 70 2>&1)
+assert_output_contains "$output" "visceral fat rating must be between 1 and 59" "Visceral fat out of range rejected"
+
+# Test 3: The list shows fat and lean mass trends
+echo -e "\n${YELLOW}Test 3: Composition trends${NC}"
+TEST_MODE=true ./bin/tracker weight add --value 196 --date 2024-01-05 --body-fat 24 > /dev/null
+TEST_MODE=true ./bin/tracker weight add --value 194 --date 2024-01-08 --body-fat 23.5 > /dev/null
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-10 2>&1)
+assert_output_contains "$output" "47.0 lbs  49.0 lbs" "Fat mass trend column"
+assert_output_contains "$output" "Fat Mass Trend : -1.9 lbs" "Fat mass trend change"
+assert_output_contains "$output" "Lean Mass Trend: -0.7 lbs" "Lean mass trend change"
+
+# Test 4: Readings can be added later
+echo -e "\n${YELLOW}Test 4: Update readings${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight update w00002 --body-fat 24.5 2>&1)
+assert_output_contains "$output" "Fat Mass:     48.5 lbs" "Body fat added on update"
+
+show_test_summary
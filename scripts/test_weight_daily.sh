@@ -0,0 +1,42 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "weight_daily"
+
+# Test 1: Several timestamped weigh-ins on a day
+echo -e "\n${YELLOW}Test 1: Morning and evening weigh-ins${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 190 --date 2024-01-01 --at 07:00 2>&1)
+assert_output_contains "$output" "Date:   2024-01-01 07:00" "Weigh-in time stored"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 192 --date 2024-01-01 --at 21:00 2>&1)
+assert_output_contains "$output" "Weight record added successfully" "Second weigh-in on the same day added"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker weight add --value 191 --date 2024-01-01 --at 07:00 2>&1)
+assert_output_contains "$output" "Record already exists for 2024-01-01 07:00" "Same time detected as a duplicate"
+TEST_MODE=true ./bin/tracker weight add --value 189 --date 2024-01-02 --at 06:45 > /dev/null
+TEST_MODE=true ./bin/tracker weight add --value 188.5 --date 2024-01-02 --at 12:00 > /dev/null
+TEST_MODE=true ./bin/tracker weight add --value 189 --date 2024-01-03 > /dev/null
+
+# Test 2: Raw entries share their day's trend
+echo -e "\n${YELLOW}Test 2: Raw entries${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-05 2>&1)
+assert_output_contains "$output" "2024-01-01  21:00      192.0 lbs      190.0 lbs" "Evening weigh-in listed with the day's trend"
+assert_output_contains "$output" "Total Records : 5" "Every weigh-in listed"
+assert_output_contains "$output" "Days          : 3" "Days counted"
+assert_output_contains "$output" "Overall Change: -1.0 lbs" "Change compares daily weights"
+
+# Test 3: Daily aggregates by policy
+echo -e "\n${YELLOW}Test 3: Daily aggregates${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-05 --daily --policy average 2>&1)
+assert_output_contains "$output" "2024-01-01      191.0 lbs" "Average of the day's weigh-ins"
+assert_output_contains "$output" "Total Records : 3" "One row per day"
+output=$(TEST_MODE=true ./bin/tracker weight daily min 2>&1)
+assert_output_contains "$output" "Daily weight set to the lightest weigh-in of the day" "Policy saved"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-05 --daily 2>&1)
+assert_output_contains "$output" "2024-01-02      188.5 lbs" "Lightest weigh-in used"
+output=$(TEST_MODE=true ./bin/tracker weight get --date 2024-01-02 2>&1)
+assert_output_contains "$output" "2 weigh-ins on this date" "Get reports several weigh-ins"
+output=$(TEST_MODE=true ./bin/tracker weight daily median 2>&1)
+assert_output_contains "$output" "invalid daily weight policy" "Invalid policy rejected"
+
+show_test_summary
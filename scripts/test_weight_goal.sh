@@ -0,0 +1,64 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "weight_goal"
+
+# Dates relative to today, since goals must end in the future
+days_ago() {
+    date -d "$1 days ago" +%Y-%m-%d
+}
+days_ahead() {
+    date -d "$1 days" +%Y-%m-%d
+}
+
+# Test 1: A goal needs a weigh-in and a future date
+echo -e "\n${YELLOW}Test 1: Goal validation${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight goal status 2>&1)
+assert_output_contains "$output" "No weight goal set" "Status without a goal rejected"
+output=$(TEST_MODE=true ./bin/tracker weight goal set 180 --by $(days_ahead 60) 2>&1)
+assert_output_contains "$output" "add a weigh-in before setting a goal" "Goal without weigh-ins rejected"
+
+# Setup test data: losing half a pound every other day
+echo -e "\n${YELLOW}Setting up test data${NC}"
+for entry in "10 190" "8 189" "6 188" "4 187"; do
+    set -- $entry
+    TEST_MODE=true ./bin/tracker weight add --value $2 --date $(days_ago $1) > /dev/null
+done
+
+output=$(TEST_MODE=true ./bin/tracker weight goal set 180 --by $(days_ago 1) 2>&1)
+assert_output_contains "$output" "goal date must be after" "Past goal date rejected"
+
+# Test 2: Setting a goal reports the pace needed
+echo -e "\n${YELLOW}Test 2: Set a goal${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight goal set 180 --by $(days_ahead 60) 2>&1)
+assert_output_contains "$output" "Goal set: 180.0 lbs by $(days_ahead 60)" "Goal saved"
+assert_output_contains "$output" "7.0 lbs to go from 187.0 lbs" "Distance from the latest weigh-in"
+
+# Test 3: Status projects from the fitted rate
+echo -e "\n${YELLOW}Test 3: Goal status${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight goal status 2>&1)
+assert_output_contains "$output" "Current Rate  : -3.5 lbs per week" "Fitted weekly rate"
+assert_output_contains "$output" "Projected Date: $(days_ahead 10)" "Projected arrival date"
+assert_output_contains "$output" "Status        : On track" "On track when projected before the goal date"
+
+# Test 4: Each weigh-in is judged against the goal
+echo -e "\n${YELLOW}Test 4: Weigh-ins against the goal${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 186.5 --date $(days_ago 2) 2>&1)
+assert_output_contains "$output" "On track for 180.0 lbs" "Weigh-in on track"
+TEST_MODE=true ./bin/tracker weight goal set 150 --by $(days_ahead 30) > /dev/null
+output=$(TEST_MODE=true ./bin/tracker weight add --value 186 --date $(days_ago 1) 2>&1)
+assert_output_contains "$output" "Behind schedule for 150.0 lbs" "Weigh-in behind schedule"
+output=$(TEST_MODE=true ./bin/tracker weight goal status --unit kg 2>&1)
+assert_output_contains "$output" "Status        : Behind schedule" "Status behind schedule"
+assert_output_contains "$output" "Goal          : 68.0 kg" "Status shown in the chosen unit"
+
+# Test 5: Earlier weigh-ins are judged against the current goal
+echo -e "\n${YELLOW}Test 5: Re-judged after the goal changes${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight get --date $(days_ago 2) 2>&1)
+assert_output_contains "$output" "Behind schedule for 150.0 lbs" "Weigh-in on track for the old goal re-judged"
+output=$(TEST_MODE=true ./bin/tracker weight get --date $(days_ago 4) 2>&1)
+assert_output_contains "$output" "Behind schedule for 150.0 lbs" "Weigh-in from before any goal judged"
+
+show_test_summary
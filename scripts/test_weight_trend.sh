@@ -0,0 +1,34 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "weight_trend"
+
+# Setup test data: noisy daily weigh-ins with a gap
+echo -e "\n${YELLOW}Setting up test data${NC}"
+for entry in "01 190" "02 192" "03 189" "04 191" "05 188" "08 189" "09 187" "10 188" "15 186"; do
+    set -- $entry
+    TEST_MODE=true ./bin/tracker weight add --value $2 --date 2024-01-$1 > /dev/null
+done
+
+# Test 1: The list shows a smoothed trend
+echo -e "\n${YELLOW}Test 1: Trend column${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "Trend" "Trend column shown"
+assert_output_contains "$output" "192.0 lbs      190.2 lbs" "Trend moves a tenth of the way toward each weigh-in"
+assert_output_contains "$output" "186.0 lbs      187.9 lbs" "Trend catches up across a gap"
+assert_output_contains "$output" "Trend Rate    : -1.0 lbs per week" "Weekly trend rate reported"
+
+# Test 2: The smoothing factor is configurable
+echo -e "\n${YELLOW}Test 2: Smoothing factor${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-31 --smoothing 1 2>&1)
+assert_output_contains "$output" "192.0 lbs      192.0 lbs" "A factor of 1 follows the scale"
+output=$(TEST_MODE=true ./bin/tracker weight smoothing 0.5 2>&1)
+assert_output_contains "$output" "Trend smoothing factor set to 0.50" "Smoothing factor saved"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "192.0 lbs      191.0 lbs" "Saved factor used"
+output=$(TEST_MODE=true ./bin/tracker weight smoothing 0 2>&1)
+assert_output_contains "$output" "smoothing factor must be greater than 0" "Invalid factor rejected"
+
+show_test_summary
@@ -0,0 +1,38 @@
+#!/bin/bash
+
+source ./scripts/test_framework.sh
+
+# Initialize test
+setup_test_env "weight_unit"
+
+# Test 1: Weights can be entered in any unit and are stored in pounds
+echo -e "\n${YELLOW}Test 1: Enter weights in other units${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 84 --unit kg --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Weight: 84.0 kg" "Kilograms shown as entered"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 13.25 --unit st --date 2024-01-09 2>&1)
+assert_output_contains "$output" "Weight: 13 st 3.5 lb" "Stone shown as stone and pounds"
+output=$(TEST_MODE=true ./bin/tracker weight get --date 2024-01-08 2>&1)
+assert_output_contains "$output" "Weight: 185.2 lbs" "Pounds shown by default"
+output=$(TEST_MODE=true ./bin/tracker weight add --value 200 --unit kg --date 2024-01-10 2>&1)
+assert_output_contains "$output" "weight must be between 34.0 kg and 113.4 kg" "Bounds converted to the unit"
+
+# Test 2: The preferred unit is used for display
+echo -e "\n${YELLOW}Test 2: Preferred unit${NC}"
+output=$(TEST_MODE=true ./bin/tracker weight unit kg 2>&1)
+assert_output_contains "$output" "Weights will be shown in kg" "Preference saved"
+output=$(TEST_MODE=true ./bin/tracker weight unit 2>&1)
+assert_output_contains "$output" "Weights are shown in kg" "Preference shown"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-31 2>&1)
+assert_output_contains "$output" "84.0 kg" "List in preferred unit"
+assert_output_contains "$output" "Overall Change: 0.1 kg" "Stats in preferred unit"
+output=$(TEST_MODE=true ./bin/tracker weight list --from 2024-01-01 --to 2024-01-31 --unit lb 2>&1)
+assert_output_contains "$output" "185.5 lbs" "--unit overrides the preference"
+output=$(TEST_MODE=true ./bin/tracker weight unit grams 2>&1)
+assert_output_contains "$output" "invalid weight unit" "Unknown unit rejected"
+
+# Test 3: Update warnings use the preferred unit
+echo -e "\n${YELLOW}Test 3: Update warning in kg${NC}"
+output=$(echo "n" | TEST_MODE=true ./bin/tracker weight update w00001 --value 90 2>&1)
+assert_output_contains "$output" "Weight change of 6.0 kg seems unusual" "Change shown in kg"
+
+show_test_summary